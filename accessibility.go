@@ -0,0 +1,96 @@
+package form
+
+import (
+	"strings"
+	
+	"github.com/creamsensation/gox"
+)
+
+const (
+	errorIdSuffix = "-error"
+	helpIdSuffix  = "-help"
+)
+
+func (f Field[T]) InputId() string {
+	return getFieldId(f.Id, f.Name)
+}
+
+func (f Field[T]) ErrorId() string {
+	return f.InputId() + errorIdSuffix
+}
+
+func (f Field[T]) HelpId() string {
+	return f.InputId() + helpIdSuffix
+}
+
+func (f Field[T]) Aria() gox.Node {
+	return gox.Fragment(
+		gox.If(len(f.Messages) > 0, gox.Attribute("aria-invalid", "true")),
+		gox.If(f.Required, gox.Attribute("aria-required", "true")),
+		f.describedBy(),
+	)
+}
+
+func (f Field[T]) ErrorNode(nodes ...gox.Node) gox.Node {
+	if len(f.Messages) == 0 {
+		return gox.Fragment()
+	}
+	messages := make([]gox.Node, len(f.Messages))
+	for i, message := range f.Messages {
+		messages[i] = gox.P(gox.Text(message))
+	}
+	return gox.Div(
+		gox.Id(f.ErrorId()),
+		gox.Fragment(nodes...),
+		gox.Fragment(messages...),
+	)
+}
+
+func (f Field[T]) HelpNode(nodes ...gox.Node) gox.Node {
+	if len(f.Text) == 0 {
+		return gox.Fragment()
+	}
+	return gox.Div(
+		gox.Id(f.HelpId()),
+		gox.Fragment(nodes...),
+		gox.Text(f.Text),
+	)
+}
+
+func (f Field[T]) describedBy() gox.Node {
+	ids := make([]string, 0, 2)
+	if len(f.Text) > 0 {
+		ids = append(ids, f.HelpId())
+	}
+	if len(f.Messages) > 0 {
+		ids = append(ids, f.ErrorId())
+	}
+	return gox.If(len(ids) > 0, gox.Attribute("aria-describedby", strings.Join(ids, " ")))
+}
+
+func (f Field[T]) base() Field[any] {
+	return Field[any]{
+		Type:      f.Type,
+		DataType:  f.DataType,
+		Id:        f.Id,
+		Name:      f.Name,
+		Label:     f.Label,
+		Text:      f.Text,
+		Value:     f.Value,
+		Messages:  f.Messages,
+		Autofocus: f.Autofocus,
+		Disabled:  f.Disabled,
+		Required:  f.Required,
+		Multiple:  f.Multiple,
+		Present:   f.Present,
+		Options:   f.Options,
+		locale:    f.locale,
+	}
+}
+
+func getFieldId(id, name string) string {
+	if len(id) > 0 {
+		return id
+	}
+	return strings.NewReplacer("[", "-", "]", "", ".", "-").Replace(name)
+}
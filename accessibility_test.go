@@ -0,0 +1,89 @@
+package form
+
+import (
+	"net/url"
+	"testing"
+	
+	"github.com/creamsensation/gox"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestAccessibility(t *testing.T) {
+	t.Run(
+		"ids", func(t *testing.T) {
+			field := Field[int]{Name: "lines[0].qty"}
+			assert.Equal(t, "lines-0-qty", field.InputId())
+			assert.Equal(t, "lines-0-qty-error", field.ErrorId())
+			assert.Equal(t, "lines-0-qty-help", field.HelpId())
+			field.Id = "qty"
+			assert.Equal(t, "qty-error", field.ErrorId())
+		},
+	)
+	t.Run(
+		"aria attributes", func(t *testing.T) {
+			field := Field[string]{Name: "email", Text: "Work email", Required: true, Messages: []string{"Invalid"}}
+			input := gox.Render(gox.Input(gox.Id(field.InputId()), field.Aria()))
+			assert.Equal(
+				t,
+				`<input id="email" aria-invalid="true" aria-required="true" aria-describedby="email-help email-error" />`,
+				input,
+			)
+			assert.Equal(t, `<input />`, gox.Render(gox.Input(Field[string]{Name: "email"}.Aria())))
+			assert.Equal(t, `<div id="email-error"><p>Invalid</p></div>`, gox.Render(field.ErrorNode()))
+			assert.Equal(t, `<div id="email-help">Work email</div>`, gox.Render(field.HelpNode()))
+			assert.Equal(t, "", gox.Render(Field[string]{}.ErrorNode()))
+			assert.Equal(t, "", gox.Render(Field[string]{}.HelpNode()))
+		},
+	)
+	t.Run(
+		"option groups", func(t *testing.T) {
+			field := Field[string]{
+				Name:     "level",
+				Label:    "Level",
+				Required: true,
+				Messages: []string{"Invalid"},
+				Options:  []Option{{Value: "1", Label: "Low"}},
+			}
+			radio := gox.Render(RadioNode(field))
+			assert.Contains(
+				t,
+				radio,
+				`<fieldset id="level" role="radiogroup" aria-required="true" aria-describedby="level-error"><legend>Level</legend>`,
+			)
+			assert.Contains(t, radio, `<input type="radio" name="level" value="1" aria-invalid="true" />`)
+			checkboxes := gox.Render(CheckboxGroupNode(field))
+			assert.Contains(t, checkboxes, `<fieldset id="level" aria-describedby="level-error"><legend>Level</legend>`)
+		},
+	)
+	t.Run(
+		"focus first invalid field", func(t *testing.T) {
+			values := url.Values{"name": {"Test"}, "email": {"test"}, "quantity": {"0"}}
+			form, err := Build[testForm](testCreateFocusBuilder().Request(testCreateValuesRequest(values)))
+			assert.Nil(t, err)
+			assert.False(t, form.Name.Autofocus)
+			assert.True(t, form.Email.Autofocus)
+			assert.False(t, form.Quantity.Autofocus)
+			form, err = BuildTestForm(testCreateFocusBuilder().Request(testCreateValuesRequest(values)))
+			assert.Nil(t, err)
+			assert.True(t, form.Email.Autofocus)
+			values.Set("email", "test@test.cz")
+			values.Set("quantity", "1")
+			form, err = Build[testForm](testCreateFocusBuilder().Request(testCreateValuesRequest(values)))
+			assert.Nil(t, err)
+			assert.True(t, form.Valid)
+			assert.True(t, form.Name.Autofocus)
+			assert.Contains(t, gox.Render(CheckboxNode(Field[bool]{Name: "agree", Autofocus: true})), `autofocus="autofocus"`)
+		},
+	)
+	t.Run(
+		"focus group item", func(t *testing.T) {
+			req := testCreateValuesRequest(url.Values{"lines[0].qty": {"1"}, "lines[1].qty": {"0"}})
+			form, err := Build[testInvoiceForm](
+				New(Add("lines").Multiple().With(Group(Add("qty").With(Number[int](), Validate.Min(1))))).Request(req),
+			)
+			assert.Nil(t, err)
+			assert.False(t, form.Lines.Value[0].Qty.Autofocus)
+			assert.True(t, form.Lines.Value[1].Qty.Autofocus)
+		},
+	)
+}
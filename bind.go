@@ -0,0 +1,109 @@
+package form
+
+import (
+	"fmt"
+	
+	"github.com/iancoleman/strcase"
+)
+
+type Binder struct {
+	builder *Builder
+	fields  []*FieldBuilder
+	index   int
+	bound   bool
+}
+
+func Bind[T any](b *Builder, bind func(*Binder) (T, error)) (T, error) {
+	return buildWith(
+		b, func(b *Builder) (T, error) {
+			validateFields(b.fields, b.messages, b.request)
+			return bind(
+				&Binder{
+					builder: b,
+					fields:  b.fields,
+					index:   -1,
+				},
+			)
+		},
+	)
+}
+
+func BindField[T any](b *Binder) (Field[T], error) {
+	fb := b.fields[b.index]
+	value, ok := fb.value.(T)
+	if !ok {
+		return Field[T]{}, fmt.Errorf("error while binding field %s: value %T is not %T", fb.fullName(), fb.value, value)
+	}
+	b.bound = true
+	fb.valid = len(fb.errors) == 0
+	return createField(fb, value, fb.errors), nil
+}
+
+func BindGroup[T any](b *Binder, bind func(*Binder) (T, error)) (Field[T], error) {
+	fb := b.fields[b.index]
+	if fb.group == nil || fb.multiple {
+		return Field[T]{}, fmt.Errorf("error while binding field %s: field is not single group", fb.fullName())
+	}
+	b.bound = true
+	value, err := bindGroupItem(fb.group.item(0), getGroupItemPath(fb, 0), bind)
+	if err != nil {
+		return Field[T]{}, err
+	}
+	fb.valid = len(fb.errors) == 0 && fb.group.isValid()
+	return createField(fb, value, fb.errors), nil
+}
+
+func BindGroups[T any](b *Binder, bind func(*Binder) (T, error)) (Field[[]T], error) {
+	fb := b.fields[b.index]
+	if fb.group == nil || !fb.multiple {
+		return Field[[]T]{}, fmt.Errorf("error while binding field %s: field is not multiple group", fb.fullName())
+	}
+	b.bound = true
+	value := make([]T, 0, len(fb.group.items))
+	for i, item := range fb.group.items {
+		itemValue, err := bindGroupItem(item, getGroupItemPath(fb, i), bind)
+		if err != nil {
+			return Field[[]T]{}, err
+		}
+		value = append(value, itemValue)
+	}
+	fb.valid = len(fb.errors) == 0 && fb.group.isValid()
+	return createField(fb, value, fb.errors), nil
+}
+
+func (b *Binder) Next() bool {
+	if b.index >= 0 && !b.bound {
+		b.fields[b.index].errors = make([]string, 0)
+	}
+	b.index++
+	b.bound = false
+	if b.index >= len(b.fields) {
+		return false
+	}
+	fb := b.fields[b.index]
+	fb.valid = fb.group == nil || fb.group.isValid()
+	return true
+}
+
+func (b *Binder) Name() string {
+	return strcase.ToCamel(b.fields[b.index].name)
+}
+
+func (b *Binder) Form() Form {
+	if b.builder == nil {
+		return Form{}
+	}
+	return createBaseForm(b.builder)
+}
+
+func bindGroupItem[T any](item *groupItem, path string, bind func(*Binder) (T, error)) (T, error) {
+	for _, field := range item.fields {
+		field.path = path + "." + field.name
+	}
+	return bind(
+		&Binder{
+			fields: item.fields,
+			index:  -1,
+		},
+	)
+}
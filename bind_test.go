@@ -0,0 +1,123 @@
+package form
+
+import (
+	"fmt"
+	"net/url"
+	"testing"
+	
+	"github.com/stretchr/testify/assert"
+)
+
+func TestBind(t *testing.T) {
+	t.Run(
+		"same as build", func(t *testing.T) {
+			values := url.Values{
+				"roles":    {"owner", "admin"},
+				"email":    {"test"},
+				"quantity": {"0"},
+				"amount":   {"1.5"},
+				"checked":  {"on"},
+			}
+			expected, err := Build[testForm](testCreateBindBuilder().Request(testCreateValuesRequest(values)))
+			assert.Nil(t, err)
+			form, err := BuildTestForm(testCreateBindBuilder().Request(testCreateValuesRequest(values)))
+			assert.Nil(t, err)
+			assert.Equal(t, expected, form)
+			assert.False(t, form.Valid)
+			assert.Equal(t, []string{defaultEmailMessage}, form.Email.Messages)
+			assert.Equal(t, CreateStruct[testForm, testModel](&expected), CreateTestModelFromTestForm(&form))
+			assert.Equal(
+				t,
+				CreateStruct[testForm, testOptionalModel](&expected),
+				CreateTestOptionalModelFromTestForm(&form),
+			)
+		},
+	)
+	t.Run(
+		"groups", func(t *testing.T) {
+			values := url.Values{
+				"lines[0].description": {"First"},
+				"lines[0].qty":         {"2"},
+				"lines[3].qty":         {"0"},
+				"address.street":       {"Main"},
+				"address.city":         {"Prague"},
+			}
+			expected, err := Build[testInvoiceForm](testCreateInvoiceBuilder().Request(testCreateValuesRequest(values)))
+			assert.Nil(t, err)
+			form, err := BuildTestInvoiceForm(testCreateInvoiceBuilder().Request(testCreateValuesRequest(values)))
+			assert.Nil(t, err)
+			assert.Equal(t, expected, form)
+			assert.False(t, form.Valid)
+			assert.Equal(t, "lines[1].qty", form.Lines.Value[1].Qty.Name)
+			assert.Equal(
+				t,
+				CreateStruct[testInvoiceForm, testInvoiceModel](&expected),
+				CreateTestInvoiceModelFromTestInvoiceForm(&form),
+			)
+		},
+	)
+	t.Run(
+		"custom and enum", func(t *testing.T) {
+			req := testCreateValuesRequest(url.Values{"owner": {"42"}, "color": {"#ff0000"}, "slug": {"hello"}})
+			form, err := BuildTestCustomForm(
+				New(
+					Add("owner").With(Value[testUserId](), Validate.Required()),
+					Add("color").With(Value[testColor]()),
+					Add("slug").With(Value[testSlug]()),
+				).Request(req),
+			)
+			assert.Nil(t, err)
+			assert.True(t, form.Valid)
+			assert.Equal(
+				t,
+				testCustomModel{Owner: 42, Color: testColor{R: 255}, Slug: "hello"},
+				CreateTestCustomModelFromTestCustomForm(&form),
+			)
+			req = testCreateValuesRequest(url.Values{"status": {"published"}})
+			enumForm, err := BuildTestEnumForm(
+				New(
+					Add("status").With(
+						Enum(
+							EnumOption[testStatus]{Value: testStatusDraft},
+							EnumOption[testStatus]{Value: testStatusPublished},
+						),
+					),
+				).Request(req),
+			)
+			assert.Nil(t, err)
+			assert.Equal(t, testStatusPublished, enumForm.Status.Value)
+		},
+	)
+	t.Run(
+		"registered converter", func(t *testing.T) {
+			RegisterConverter[testUserId](
+				func(value string) (testUserId, error) {
+					var id testUserId
+					_, err := fmt.Sscanf(value, "u-%d", &id)
+					return id, err
+				},
+				func(id testUserId) string {
+					return fmt.Sprintf("u-%d", id)
+				},
+			)
+			t.Cleanup(unregisterConverter[testUserId])
+			form, err := BuildTestCustomForm(
+				New(Add("owner").With(Value[testUserId](), Validate.Required())).Request(
+					testCreateValuesRequest(url.Values{"owner": {"u-42"}}),
+				),
+			)
+			assert.Nil(t, err)
+			assert.True(t, form.Valid)
+			assert.Equal(t, testUserId(42), form.Owner.Value)
+			assert.Equal(t, "u-42", form.Owner.String())
+		},
+	)
+	t.Run(
+		"type mismatch", func(t *testing.T) {
+			_, err := BuildTestForm(New(Add("name").With(Number[int]())))
+			assert.Error(t, err)
+			_, err = BuildTestInvoiceForm(New(Add("lines").With(Group(Add("description").With(Text())))))
+			assert.Error(t, err)
+		},
+	)
+}
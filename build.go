@@ -1,9 +1,10 @@
 package form
 
 import (
+	"context"
 	"fmt"
-	"net/http"
 	"reflect"
+	"slices"
 	"time"
 	
 	"github.com/iancoleman/strcase"
@@ -22,49 +23,153 @@ func MustBuild[T any](b *Builder) T {
 }
 
 func getContentType(b *Builder) string {
-	for _, f := range b.fields {
+	if hasFileField(b.fields) {
+		return contentTypeMultipartForm
+	}
+	return contentTypeForm
+}
+
+func hasFileField(fields []*FieldBuilder) bool {
+	for _, f := range fields {
 		if f.dataType == fieldDataTypeFile {
-			return contentTypeMultipartForm
+			return true
+		}
+		if f.group != nil && hasFileField(f.group.fields) {
+			return true
 		}
 	}
-	return contentTypeForm
+	return false
 }
 
 func Build[T any](b *Builder) (T, error) {
+	return buildWith(
+		b, func(b *Builder) (T, error) {
+			return buildForm[T](b), nil
+		},
+	)
+}
+
+func buildWith[T any](b *Builder, build func(*Builder) (T, error)) (result T, resultErr error) {
+	defer func() {
+		b.built = resultErr == nil
+	}()
 	if b.request == nil {
-		return buildForm[T](b), nil
+		return build(b)
 	}
 	b.submitted = isFormSubmitted(b.request)
 	b.contentType = getContentType(b)
-	reqFormData, reqFormFiles, err := processRequest(b.request, b.limit)
-	if err != nil {
-		return *new(T), fmt.Errorf("error processing request to form: %w", err)
+	if b.submitted {
+		resetFieldsPresent(b.fields)
 	}
-	if len(reqFormData) > 0 {
+	if b.stream && isRequestMultipartForm(b.request) {
+		reqFormData, reqFormFiles, err := processMultipartStream(b.request, b.limit, b.threshold)
+		if err != nil {
+			return *new(T), fmt.Errorf("error processing request to form: %w", err)
+		}
 		processFormData(b, reqFormData)
+		processFieldsFiles(b.fields, reqFormFiles)
+		context.AfterFunc(
+			b.request.Context(), func() {
+				removeMultipartFiles(reqFormFiles)
+			},
+		)
+		defer func() {
+			if resultErr != nil || !b.submitted || !b.isValid() {
+				removeMultipartFiles(reqFormFiles)
+			}
+		}()
 	}
-	if len(reqFormFiles) > 0 {
-		err := processFormFiles(b, reqFormFiles)
+	if !b.stream || !isRequestMultipartForm(b.request) {
+		reqFormData, reqFormFiles, err := processRequest(b.request, b.limit)
 		if err != nil {
+			return *new(T), fmt.Errorf("error processing request to form: %w", err)
+		}
+		if len(reqFormData) > 0 {
+			processFormData(b, reqFormData)
+		}
+		if len(reqFormFiles) > 0 {
+			err := processFormFiles(b, reqFormFiles)
+			if err != nil {
+				return *new(T), err
+			}
+		}
+	}
+	if isGroupAction(b.request) {
+		b.submitted = false
+		processGroupAction(b, b.request.Form)
+	}
+	form, err := build(b)
+	if err != nil {
+		return *new(T), err
+	}
+	if b.submitted && !b.isValid() && hasStashField(b.fields) {
+		if err := stashFormFiles(b.fields); err != nil {
+			return *new(T), err
+		}
+		updateFormFiles(reflect.ValueOf(&form).Elem(), b.fields)
+	}
+	if b.submitted && b.isValid() && hasStorageField(b.fields) {
+		if err := storeFormFiles(b.request.Context(), b.fields); err != nil {
 			return *new(T), err
 		}
+		updateFormFiles(reflect.ValueOf(&form).Elem(), b.fields)
+	}
+	return form, nil
+}
+
+func updateFormFiles(formRef reflect.Value, fields []*FieldBuilder) {
+	if formRef.Kind() != reflect.Struct {
+		return
+	}
+	for _, fb := range fields {
+		formField := formRef.FieldByName(strcase.ToCamel(fb.name))
+		if !formField.IsValid() || formField.Kind() != reflect.Struct {
+			continue
+		}
+		valueField := formField.FieldByName(valueFieldName)
+		if !valueField.IsValid() {
+			continue
+		}
+		if fb.group != nil && fb.multiple && valueField.Kind() == reflect.Slice {
+			for i, item := range fb.group.items {
+				if i < valueField.Len() {
+					updateFormFiles(valueField.Index(i), item.fields)
+				}
+			}
+			continue
+		}
+		if fb.group != nil && !fb.multiple {
+			updateFormFiles(valueField, fb.group.item(0).fields)
+			continue
+		}
+		if fb.storage == nil && fb.stash == nil {
+			continue
+		}
+		value := reflect.ValueOf(fb.value)
+		if value.IsValid() && value.Type().AssignableTo(valueField.Type()) {
+			valueField.Set(value)
+		}
 	}
-	return buildForm[T](b), nil
 }
 
 func buildForm[T any](b *Builder) T {
 	form := new(T)
 	formRef := reflect.ValueOf(form)
-	for i, fb := range b.fields {
-		fb.messages = b.messages
-		errors := buildFormField(formRef, fb, b.request)
-		b.fields[i].valid = len(errors) == 0
-	}
+	validateFields(b.fields, b.messages, b.request)
+	buildFormFields(formRef, b.fields)
 	buildBaseForm(formRef, b)
 	return *form
 }
 
-func buildFormField(formRef reflect.Value, fb *FieldBuilder, req *http.Request) []string {
+func buildFormFields(formRef reflect.Value, fields []*FieldBuilder) {
+	for i, fb := range fields {
+		errors := buildFormField(formRef, fb)
+		fields[i].errors = errors
+		fields[i].valid = len(errors) == 0 && (fb.group == nil || fb.group.isValid())
+	}
+}
+
+func buildFormField(formRef reflect.Value, fb *FieldBuilder) []string {
 	errors := make([]string, 0)
 	formField := formRef.Elem().FieldByName(strcase.ToCamel(fb.name))
 	if !formField.IsValid() {
@@ -73,67 +178,54 @@ func buildFormField(formRef reflect.Value, fb *FieldBuilder, req *http.Request)
 	switch fb.dataType {
 	case fieldDataTypeString:
 		if fb.multiple {
-			field := createFormField[[]string](fb, req)
-			formField.Set(reflect.ValueOf(field))
-			return field.Messages
-		}
-		if !fb.multiple {
-			field := createFormField[string](fb, req)
-			formField.Set(reflect.ValueOf(field))
-			return field.Messages
-		}
-	case fieldDataTypeFloat:
-		if fb.multiple {
-			field := createFormField[[]float64](fb, req)
-			formField.Set(reflect.ValueOf(field))
-			return field.Messages
-		}
-		if !fb.multiple {
-			field := createFormField[float64](fb, req)
-			formField.Set(reflect.ValueOf(field))
-			return field.Messages
-		}
-	case fieldDataTypeInt:
-		if fb.multiple {
-			field := createFormField[[]int](fb, req)
+			field := createFormField[[]string](fb)
 			formField.Set(reflect.ValueOf(field))
 			return field.Messages
 		}
 		if !fb.multiple {
-			field := createFormField[int](fb, req)
+			field := createFormField[string](fb)
 			formField.Set(reflect.ValueOf(field))
 			return field.Messages
 		}
+	case fieldDataTypeInt, fieldDataTypeUint, fieldDataTypeFloat, fieldDataTypeDecimal, fieldDataTypeMoney,
+		fieldDataTypeCustom, fieldDataTypeEnum:
+		field, messages := createValueField(formField.Type(), fb)
+		formField.Set(field)
+		return messages
 	case fieldDataTypeBool:
 		if fb.multiple {
-			field := createFormField[[]bool](fb, req)
+			field := createFormField[[]bool](fb)
 			formField.Set(reflect.ValueOf(field))
 			return field.Messages
 		}
 		if !fb.multiple {
-			field := createFormField[bool](fb, req)
+			field := createFormField[bool](fb)
 			formField.Set(reflect.ValueOf(field))
 			return field.Messages
 		}
 	case fieldDataTypeFile:
 		if fb.multiple {
-			field := createFormField[[]Multipart](fb, req)
+			field := createFormField[[]Multipart](fb)
 			formField.Set(reflect.ValueOf(field))
 			return field.Messages
 		}
 		if !fb.multiple {
-			field := createFormField[Multipart](fb, req)
+			field := createFormField[Multipart](fb)
 			formField.Set(reflect.ValueOf(field))
 			return field.Messages
 		}
+	case fieldDataTypeGroup:
+		field, messages := createGroupField(formField.Type(), fb)
+		formField.Set(field)
+		return messages
 	case fieldDataTypeTime:
 		if fb.multiple {
-			field := createFormField[[]time.Time](fb, req)
+			field := createFormField[[]time.Time](fb)
 			formField.Set(reflect.ValueOf(field))
 			return field.Messages
 		}
 		if !fb.multiple {
-			field := createFormField[time.Time](fb, req)
+			field := createFormField[time.Time](fb)
 			formField.Set(reflect.ValueOf(field))
 			return field.Messages
 		}
@@ -161,22 +253,90 @@ func createBaseForm(b *Builder) Form {
 		Valid:       b.isValid(),
 		Submitted:   b.submitted,
 		Hx:          b.hx,
+		Locale:      b.locale,
+		state: &formState{
+			errors:      slices.Clone(b.errors),
+			fieldErrors: collectFieldErrors(b.fields, nil),
+			fields:      b.fields,
+			renderer:    b.renderer,
+			layout:      b.layout,
+		},
 	}
 }
 
-func createFormField[T any](fb *FieldBuilder, req *http.Request) Field[T] {
+func createFormField[T any](fb *FieldBuilder) Field[T] {
+	return createField(fb, fb.value.(T), fb.errors)
+}
+
+func createField[T any](fb *FieldBuilder, value T, messages []string) Field[T] {
 	return Field[T]{
 		Id:        fb.id,
-		Name:      fb.name,
+		Name:      fb.fullName(),
 		Type:      fb.fieldType,
 		DataType:  fb.dataType,
 		Label:     fb.label,
 		Text:      fb.text,
-		Value:     fb.value.(T),
+		Value:     value,
 		Multiple:  fb.multiple,
-		Messages:  validateField(fb, req),
+		Present:   fb.present,
+		Options:   fb.getOptions(),
+		Messages:  messages,
 		Required:  fb.isRequired(),
 		Disabled:  fb.disabled,
-		Autofocus: fb.autofocus,
+		Autofocus: fb.focus,
+	}
+}
+
+func createValueField(fieldType reflect.Type, fb *FieldBuilder) (reflect.Value, []string) {
+	messages := fb.errors
+	field := createReflectField(fieldType, fb, messages)
+	valueField := field.FieldByName(valueFieldName)
+	if valueField.IsValid() {
+		value := reflect.ValueOf(fb.value)
+		if !isTypeSettable(value.Type(), valueField.Type()) {
+			panic(fmt.Errorf("error while building field %s: value %s is not %s", fb.fullName(), value.Type(), valueField.Type()))
+		}
+		setStructValue(value, valueField)
+	}
+	return field, messages
+}
+
+func isTypeSettable(value, target reflect.Type) bool {
+	switch {
+	case value.AssignableTo(target):
+		return true
+	case value.Kind() == reflect.Slice && target.Kind() == reflect.Slice:
+		return isTypeSettable(value.Elem(), target.Elem())
+	}
+	return value.Kind() == target.Kind() && value.ConvertibleTo(target)
+}
+
+func createReflectField(fieldType reflect.Type, fb *FieldBuilder, messages []string) reflect.Value {
+	field := reflect.New(fieldType).Elem()
+	base := reflect.ValueOf(
+		Field[any]{
+			Id:        fb.id,
+			Name:      fb.fullName(),
+			Type:      fb.fieldType,
+			DataType:  fb.dataType,
+			Label:     fb.label,
+			Text:      fb.text,
+			Multiple:  fb.multiple,
+			Present:   fb.present,
+			Options:   fb.getOptions(),
+			Messages:  messages,
+			Required:  fb.isRequired(),
+			Disabled:  fb.disabled,
+			Autofocus: fb.focus,
+		},
+	)
+	for i := 0; i < base.NumField(); i++ {
+		name := base.Type().Field(i).Name
+		target := field.FieldByName(name)
+		if name == valueFieldName || !target.IsValid() || !target.CanSet() {
+			continue
+		}
+		target.Set(base.Field(i))
 	}
+	return field
 }
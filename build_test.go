@@ -76,10 +76,10 @@ func TestFormFieldBuild(t *testing.T) {
 			quantity := Add("quantity").With(Number[int](testQuantityValue))
 			amount := Add("amount").With(Number[float64](testAmountValue))
 			checked := Add("checked").With(Checkbox(testCheckedValue))
-			buildFormField(formRef, name, nil)
-			buildFormField(formRef, quantity, nil)
-			buildFormField(formRef, amount, nil)
-			buildFormField(formRef, checked, nil)
+			buildFormField(formRef, name)
+			buildFormField(formRef, quantity)
+			buildFormField(formRef, amount)
+			buildFormField(formRef, checked)
 			assert.Equal(t, name.value, form.Name.Value)
 			assert.Equal(t, quantity.value, form.Quantity.Value)
 			assert.Equal(t, amount.value, form.Amount.Value)
@@ -89,7 +89,7 @@ func TestFormFieldBuild(t *testing.T) {
 	t.Run(
 		"create form field", func(t *testing.T) {
 			fb := Add("name").With(Text(testNameValue))
-			f := createFormField[string](fb, nil)
+			f := createFormField[string](fb)
 			f.Messages = validateField(fb, nil)
 			fb.valid = len(f.Messages) == 0
 			assert.Equal(t, f.Type, fb.fieldType)
@@ -102,3 +102,39 @@ func TestFormFieldBuild(t *testing.T) {
 		},
 	)
 }
+
+func BenchmarkBuild(b *testing.B) {
+	values := testCreateBenchmarkValues()
+	b.Run(
+		"get", func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				if _, err := Build[testBenchmarkForm](testCreateBenchmarkBuilder(nil)); err != nil {
+					b.Fatal(err)
+				}
+			}
+		},
+	)
+	b.Run(
+		"post", func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				form, err := Build[testBenchmarkForm](testCreateBenchmarkBuilder(testCreateValuesRequest(values)))
+				if err != nil || !form.Valid {
+					b.Fatal("invalid form", err)
+				}
+			}
+		},
+	)
+	b.Run(
+		"generated", func(b *testing.B) {
+			b.ReportAllocs()
+			for i := 0; i < b.N; i++ {
+				form, err := BuildTestBenchmarkForm(testCreateBenchmarkBuilder(testCreateValuesRequest(values)))
+				if err != nil || !form.Valid {
+					b.Fatal("invalid form", err)
+				}
+			}
+		},
+	)
+}
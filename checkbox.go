@@ -0,0 +1,190 @@
+package form
+
+import (
+	"reflect"
+	"slices"
+	"strconv"
+	
+	"github.com/creamsensation/gox"
+)
+
+type Option struct {
+	Value   string
+	Label   string
+	Checked bool
+}
+
+const (
+	defaultCheckboxValue = "on"
+)
+
+func CheckboxGroup(options ...Option) FieldConfig {
+	value := make([]string, 0)
+	for _, option := range options {
+		if option.Checked {
+			value = append(value, option.Value)
+		}
+	}
+	return FieldConfig{
+		fieldType: fieldTypeCheckbox,
+		dataType:  fieldDataTypeString,
+		value:     value,
+		options:   options,
+		multiple:  true,
+	}
+}
+
+func CheckboxGroupOf[T comparable](options ...EnumOption[T]) FieldConfig {
+	config := Enum(options...)
+	config.fieldType = fieldTypeCheckbox
+	config.multiple = true
+	return config
+}
+
+func (b *FieldBuilder) CheckboxValue(value string) *FieldBuilder {
+	b.checkValue = value
+	return b
+}
+
+func CheckboxNode(field Field[bool], nodes ...gox.Node) gox.Node {
+	value := defaultCheckboxValue
+	if len(field.Options) > 0 {
+		value = field.Options[0].Value
+	}
+	return gox.Input(
+		gox.Type(fieldTypeCheckbox),
+		gox.Id(field.InputId()),
+		gox.Name(field.Name),
+		gox.Value(value),
+		gox.If(field.Value, gox.Attribute("checked", "checked")),
+		gox.If(field.Disabled, gox.Attribute("disabled", "disabled")),
+		gox.If(field.Autofocus, gox.Attribute("autofocus", "autofocus")),
+		field.Aria(),
+		gox.Fragment(nodes...),
+	)
+}
+
+func CheckboxGroupNode[T any](field Field[T], nodes ...gox.Node) gox.Node {
+	return createOptionsNode(fieldTypeCheckbox, field.base(), nodes...)
+}
+
+func createOptionsNode(inputType string, field Field[any], nodes ...gox.Node) gox.Node {
+	items := make([]gox.Node, len(field.Options))
+	for i, option := range field.Options {
+		items[i] = gox.Label(
+			gox.Input(
+				gox.Type(inputType),
+				gox.Name(field.Name),
+				gox.Value(option.Value),
+				gox.If(option.Checked, gox.Attribute("checked", "checked")),
+				gox.If(field.Disabled, gox.Attribute("disabled", "disabled")),
+				gox.If(field.Autofocus && i == 0, gox.Attribute("autofocus", "autofocus")),
+				gox.If(len(field.Messages) > 0, gox.Attribute("aria-invalid", "true")),
+			),
+			gox.Text(option.Label),
+		)
+	}
+	return gox.Fieldset(
+		gox.Id(field.InputId()),
+		gox.If(inputType == fieldTypeRadio, gox.Attribute("role", "radiogroup")),
+		gox.If(inputType == fieldTypeRadio && field.Required, gox.Attribute("aria-required", "true")),
+		field.describedBy(),
+		gox.If(len(field.Label) > 0, gox.Legend(gox.Text(field.Label))),
+		gox.Fragment(items...),
+		gox.Fragment(nodes...),
+	)
+}
+
+func (b *FieldBuilder) getCheckValue() string {
+	if len(b.checkValue) > 0 {
+		return b.checkValue
+	}
+	return defaultCheckboxValue
+}
+
+func (b *FieldBuilder) getOptions() []Option {
+	if b.fieldType == fieldTypeCheckbox && b.dataType == fieldDataTypeBool && b.multiple {
+		values, _ := b.value.([]bool)
+		options := make([]Option, len(values))
+		for i, checked := range values {
+			options[i] = Option{Value: strconv.Itoa(i), Checked: checked}
+		}
+		return options
+	}
+	if b.fieldType == fieldTypeCheckbox && b.dataType == fieldDataTypeBool {
+		checked, _ := b.value.(bool)
+		return []Option{{Value: b.getCheckValue(), Label: b.label, Checked: checked}}
+	}
+	if len(b.options) == 0 {
+		return nil
+	}
+	values := getFormattedValues(b.value)
+	options := make([]Option, len(b.options))
+	for i, option := range b.options {
+		option.Checked = slices.Contains(values, option.Value)
+		options[i] = option
+	}
+	return options
+}
+
+func processOptions(fb *FieldBuilder, values []string) {
+	t := reflect.TypeOf(fb.value)
+	sliceType := t
+	if !fb.multiple {
+		sliceType = reflect.SliceOf(t)
+	}
+	result := reflect.MakeSlice(sliceType, 0, len(values))
+	fb.invalid = false
+	for _, value := range values {
+		if len(value) == 0 {
+			continue
+		}
+		index := slices.IndexFunc(
+			fb.options, func(option Option) bool {
+				return option.Value == value
+			},
+		)
+		if index == -1 {
+			fb.invalid = true
+			continue
+		}
+		item := reflect.ValueOf(value)
+		if fb.enum != nil {
+			item = reflect.ValueOf(fb.enum[index])
+		}
+		result = reflect.Append(result, item)
+	}
+	fb.present = result.Len() > 0
+	if fb.multiple {
+		fb.value = result.Interface()
+	}
+	if !fb.multiple && result.Len() > 0 {
+		fb.value = result.Index(0).Interface()
+	}
+	if !fb.multiple && result.Len() == 0 {
+		fb.value = reflect.Zero(t).Interface()
+	}
+}
+
+func parseCheckedIndexes(count int, values []string) ([]bool, bool) {
+	result := make([]bool, count)
+	invalid := false
+	for _, value := range values {
+		index, err := strconv.Atoi(value)
+		if err != nil || index < 0 || index >= count {
+			invalid = true
+			continue
+		}
+		result[index] = true
+	}
+	return result, invalid
+}
+
+func getFormattedValues(value any) []string {
+	values := getValueItems(value)
+	result := make([]string, len(values))
+	for i, item := range values {
+		result[i] = formatValue(item)
+	}
+	return result
+}
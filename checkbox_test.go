@@ -0,0 +1,115 @@
+package form
+
+import (
+	"net/url"
+	"testing"
+	
+	"github.com/creamsensation/gox"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckbox(t *testing.T) {
+	t.Run(
+		"default", func(t *testing.T) {
+			form, err := Build[testCheckboxForm](testCreateCheckboxBuilder())
+			assert.Nil(t, err)
+			assert.Equal(t, []string{"editor"}, form.Roles.Value)
+			assert.True(t, form.Roles.Multiple)
+			assert.Equal(t, []bool{false, true, false}, testCheckedOptions(form.Roles.Options))
+		},
+	)
+	t.Run(
+		"submitted values", func(t *testing.T) {
+			req := testCreateValuesRequest(url.Values{"roles": {"admin", "viewer"}, "agree": {"yes"}})
+			form, err := Build[testCheckboxForm](testCreateCheckboxBuilder().Request(req))
+			assert.Nil(t, err)
+			assert.True(t, form.Valid)
+			assert.Equal(t, []string{"admin", "viewer"}, form.Roles.Value)
+			assert.Equal(t, []bool{true, false, true}, testCheckedOptions(form.Roles.Options))
+			assert.True(t, form.Agree.Value)
+		},
+	)
+	t.Run(
+		"unchecked", func(t *testing.T) {
+			req := testCreateValuesRequest(url.Values{"agree": {"on"}})
+			form, err := Build[testCheckboxForm](testCreateCheckboxBuilder().Request(req))
+			assert.Nil(t, err)
+			assert.False(t, form.Valid)
+			assert.Equal(t, []string{}, form.Roles.Value)
+			assert.Equal(t, []string{defaultMinItemsMessage}, form.Roles.Messages)
+			assert.False(t, form.Agree.Value)
+		},
+	)
+	t.Run(
+		"unknown and max", func(t *testing.T) {
+			req := testCreateValuesRequest(url.Values{"roles": {"admin", "root"}})
+			form, err := Build[testCheckboxForm](testCreateCheckboxBuilder().Request(req))
+			assert.Nil(t, err)
+			assert.Equal(t, []string{defaultInvalidMessage}, form.Roles.Messages)
+			req = testCreateValuesRequest(url.Values{"roles": {"admin", "editor", "viewer"}})
+			form, err = Build[testCheckboxForm](testCreateCheckboxBuilder().Request(req))
+			assert.Nil(t, err)
+			assert.Equal(t, []string{defaultMaxItemsMessage}, form.Roles.Messages)
+		},
+	)
+	t.Run(
+		"typed values", func(t *testing.T) {
+			form, err := Build[testCheckboxForm](testCreateCheckboxBuilder())
+			assert.Nil(t, err)
+			assert.Equal(t, []testLevel{1}, form.Levels.Value)
+			req := testCreateValuesRequest(url.Values{"roles": {"admin"}, "levels": {"2"}})
+			form, err = Build[testCheckboxForm](testCreateCheckboxBuilder().Request(req))
+			assert.Nil(t, err)
+			assert.True(t, form.Valid)
+			assert.Equal(t, []testLevel{2}, form.Levels.Value)
+			assert.Equal(t, []bool{false, true}, testCheckedOptions(form.Levels.Options))
+			expected, err := BuildTestCheckboxForm(testCreateCheckboxBuilder().Request(testCreateValuesRequest(req.PostForm)))
+			assert.Nil(t, err)
+			assert.Equal(t, expected, form)
+			req = testCreateValuesRequest(url.Values{"roles": {"admin"}, "levels": {"1", "2"}})
+			form, err = Build[testCheckboxForm](testCreateCheckboxBuilder().Request(req))
+			assert.Nil(t, err)
+			assert.Equal(t, []string{defaultMaxItemsMessage}, form.Levels.Messages)
+			req = testCreateValuesRequest(url.Values{"roles": {"admin"}, "levels": {"3"}})
+			form, err = Build[testCheckboxForm](testCreateCheckboxBuilder().Request(req))
+			assert.Nil(t, err)
+			assert.Equal(t, []string{defaultInvalidMessage}, form.Levels.Messages)
+		},
+	)
+	t.Run(
+		"multiple bool", func(t *testing.T) {
+			form, err := Build[testCheckboxForm](testCreateCheckboxBuilder())
+			assert.Nil(t, err)
+			assert.Equal(t, []bool{false, true, false}, form.Flags.Value)
+			req := testCreateValuesRequest(url.Values{"roles": {"admin"}, "flags": {"0", "2"}})
+			form, err = Build[testCheckboxForm](testCreateCheckboxBuilder().Request(req))
+			assert.Nil(t, err)
+			assert.True(t, form.Valid)
+			assert.True(t, form.Flags.Present)
+			assert.Equal(t, []bool{true, false, true}, form.Flags.Value)
+			assert.Equal(t, []bool{true, false, true}, testCheckedOptions(form.Flags.Options))
+			flags := gox.Render(CheckboxGroupNode(form.Flags))
+			assert.Contains(t, flags, `name="flags" value="0" checked="checked"`)
+			assert.Contains(t, flags, `name="flags" value="1" />`)
+			assert.Contains(t, gox.Render(FieldNode(form.Form, form.Flags)), `<input type="checkbox" id="flags-2" name="flags" value="2" checked="checked" />`)
+			req = testCreateValuesRequest(url.Values{"roles": {"admin"}, "flags": {"3"}})
+			form, err = Build[testCheckboxForm](testCreateCheckboxBuilder().Request(req))
+			assert.Nil(t, err)
+			assert.Equal(t, []string{defaultInvalidMessage}, form.Flags.Messages)
+			assert.Equal(t, []bool{false, false, false}, form.Flags.Value)
+		},
+	)
+	t.Run(
+		"render", func(t *testing.T) {
+			req := testCreateValuesRequest(url.Values{"roles": {"admin"}, "agree": {"yes"}})
+			form, err := Build[testCheckboxForm](testCreateCheckboxBuilder().Request(req))
+			assert.Nil(t, err)
+			roles := gox.Render(CheckboxGroupNode(form.Roles))
+			assert.Contains(t, roles, `value="admin" checked="checked"`)
+			assert.NotContains(t, roles, `value="editor" checked="checked"`)
+			assert.Contains(t, roles, "Editor")
+			agree := gox.Render(CheckboxNode(form.Agree))
+			assert.Contains(t, agree, `value="yes" checked="checked"`)
+		},
+	)
+}
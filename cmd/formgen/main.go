@@ -0,0 +1,487 @@
+package main
+
+import (
+	"bytes"
+	"errors"
+	"flag"
+	"fmt"
+	"go/ast"
+	"go/format"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"log"
+	"os"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+	
+	"github.com/iancoleman/strcase"
+)
+
+type spec struct {
+	form  string
+	model string
+}
+
+type structType struct {
+	name string
+	spec *ast.StructType
+	file *ast.File
+}
+
+type generator struct {
+	pkg       string
+	qualifier string
+	structs   map[string]*structType
+	types     map[string]ast.Expr
+	imports   map[string]string
+	generated map[string]bool
+	mappings  []spec
+	buf       bytes.Buffer
+}
+
+const (
+	formImportPath = "github.com/creamsensation/form"
+	formPkgName    = "form"
+	formTypeName   = "Form"
+	fieldTypeName  = "Field"
+	outputSuffix   = "-gen.go"
+)
+
+func main() {
+	typeFlag := flag.String("type", "", "comma separated form types, optionally with model type (UserForm=User)")
+	outputFlag := flag.String("output", "", "output file name, default is <type>-gen.go")
+	dirFlag := flag.String("dir", ".", "package directory")
+	flag.Parse()
+	specs, err := parseSpecs(*typeFlag)
+	if err != nil {
+		log.Fatal(err)
+	}
+	output := *outputFlag
+	if len(output) == 0 {
+		output = strcase.ToKebab(specs[0].form) + outputSuffix
+	}
+	src, err := generate(*dirFlag, filepath.Base(output), specs)
+	if err != nil {
+		log.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(*dirFlag, output), src, 0644); err != nil {
+		log.Fatal(err)
+	}
+}
+
+func parseSpecs(value string) ([]spec, error) {
+	result := make([]spec, 0)
+	for _, item := range strings.Split(value, ",") {
+		item = strings.TrimSpace(item)
+		if len(item) == 0 {
+			continue
+		}
+		form, model, _ := strings.Cut(item, "=")
+		result = append(result, spec{form: strings.TrimSpace(form), model: strings.TrimSpace(model)})
+	}
+	if len(result) == 0 {
+		return nil, errors.New("missing -type flag")
+	}
+	return result, nil
+}
+
+func generate(dir, output string, specs []spec) ([]byte, error) {
+	g, err := parsePackage(dir, output)
+	if err != nil {
+		return nil, err
+	}
+	body, err := g.generate(specs)
+	if err != nil {
+		return nil, err
+	}
+	src := new(bytes.Buffer)
+	src.WriteString("// Code generated by formgen. DO NOT EDIT.\n\n")
+	src.WriteString("package " + g.pkg + "\n\n")
+	if len(g.imports) > 0 {
+		names := make([]string, 0, len(g.imports))
+		for name := range g.imports {
+			names = append(names, name)
+		}
+		sort.Slice(
+			names, func(i, j int) bool {
+				return g.imports[names[i]] < g.imports[names[j]]
+			},
+		)
+		src.WriteString("import (\n")
+		for _, name := range names {
+			path := g.imports[name]
+			if name == filepath.Base(path) {
+				src.WriteString(strconv.Quote(path) + "\n")
+				continue
+			}
+			src.WriteString(name + " " + strconv.Quote(path) + "\n")
+		}
+		src.WriteString(")\n\n")
+	}
+	src.Write(body)
+	result, err := format.Source(src.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("error while formatting generated code: %w", err)
+	}
+	return result, nil
+}
+
+func parsePackage(dir, output string) (*generator, error) {
+	entries, err := os.ReadDir(dir)
+	if err != nil {
+		return nil, err
+	}
+	g := &generator{
+		structs:   make(map[string]*structType),
+		types:     make(map[string]ast.Expr),
+		imports:   make(map[string]string),
+		generated: make(map[string]bool),
+	}
+	fset := token.NewFileSet()
+	for _, entry := range entries {
+		name := entry.Name()
+		if entry.IsDir() || !strings.HasSuffix(name, ".go") || strings.HasSuffix(name, "_test.go") || name == output {
+			continue
+		}
+		file, err := parser.ParseFile(fset, filepath.Join(dir, name), nil, parser.SkipObjectResolution)
+		if err != nil {
+			return nil, err
+		}
+		g.pkg = file.Name.Name
+		for _, decl := range file.Decls {
+			genDecl, ok := decl.(*ast.GenDecl)
+			if !ok || genDecl.Tok != token.TYPE {
+				continue
+			}
+			for _, s := range genDecl.Specs {
+				typeSpec := s.(*ast.TypeSpec)
+				if typeSpec.TypeParams == nil {
+					g.types[typeSpec.Name.Name] = typeSpec.Type
+				}
+				if st, ok := typeSpec.Type.(*ast.StructType); ok && typeSpec.TypeParams == nil {
+					g.structs[typeSpec.Name.Name] = &structType{name: typeSpec.Name.Name, spec: st, file: file}
+				}
+			}
+		}
+	}
+	if len(g.pkg) == 0 {
+		return nil, fmt.Errorf("missing go files in %s", dir)
+	}
+	return g, nil
+}
+
+func (g *generator) generate(specs []spec) ([]byte, error) {
+	for _, s := range specs {
+		st, ok := g.structs[s.form]
+		if !ok {
+			return nil, fmt.Errorf("missing struct type %s", s.form)
+		}
+		g.qualifier = getFormQualifier(st.file, g.pkg)
+		if len(s.model) > 0 {
+			if _, ok := g.structs[s.model]; !ok {
+				return nil, fmt.Errorf("missing struct type %s", s.model)
+			}
+			g.mappings = append(g.mappings, s)
+		}
+		if g.generated[s.form] {
+			continue
+		}
+		if !g.embedsForm(st) {
+			return nil, fmt.Errorf("struct type %s does not embed %s", s.form, formTypeName)
+		}
+		g.generated[s.form] = true
+		g.writeBuild(st)
+		g.writeBind(st)
+	}
+	for i := 0; i < len(g.mappings); i++ {
+		m := g.mappings[i]
+		name := getMappingName(m.form, m.model)
+		if g.generated[name] {
+			continue
+		}
+		g.generated[name] = true
+		if err := g.writeMapping(g.structs[m.form], g.structs[m.model]); err != nil {
+			return nil, err
+		}
+	}
+	if len(g.qualifier) > 0 {
+		g.imports[g.qualifier] = formImportPath
+	}
+	return g.buf.Bytes(), nil
+}
+
+func (g *generator) writeBuild(st *structType) {
+	g.printf("func Build%s(b *%s) (%s, error) {\n", strcase.ToCamel(st.name), g.qualify("Builder"), st.name)
+	g.printf("return %s(b, %s)\n", g.qualify("Bind"), getBindName(st.name))
+	g.printf("}\n\n")
+}
+
+func (g *generator) writeBind(st *structType) {
+	g.printf("func %s(binder *%s) (%s, error) {\n", getBindName(st.name), g.qualify("Binder"), st.name)
+	g.printf("var result %s\n", st.name)
+	g.printf("for binder.Next() {\n")
+	g.printf("var err error\n")
+	g.printf("switch binder.Name() {\n")
+	groups := make([]*structType, 0)
+	for _, field := range st.spec.Fields.List {
+		valueType, ok := g.fieldValueType(field.Type)
+		if !ok {
+			continue
+		}
+		for _, name := range field.Names {
+			g.printf("case %s:\n", strconv.Quote(name.Name))
+			if group, ok := g.groupStruct(valueType); ok {
+				g.printf("result.%s, err = %s(binder, %s)\n", name.Name, g.qualify("BindGroup"), getBindName(group.name))
+				groups = append(groups, group)
+				continue
+			}
+			if array, ok := valueType.(*ast.ArrayType); ok && array.Len == nil {
+				if group, ok := g.groupStruct(array.Elt); ok {
+					g.printf("result.%s, err = %s(binder, %s)\n", name.Name, g.qualify("BindGroups"), getBindName(group.name))
+					groups = append(groups, group)
+					continue
+				}
+			}
+			g.printf("result.%s, err = %s[%s](binder)\n", name.Name, g.qualify("BindField"), g.typeString(valueType, st.file))
+		}
+	}
+	g.printf("}\n")
+	g.printf("if err != nil {\n")
+	g.printf("return result, err\n")
+	g.printf("}\n")
+	g.printf("}\n")
+	if g.embedsForm(st) {
+		g.printf("result.%s = binder.Form()\n", formTypeName)
+	}
+	g.printf("return result, nil\n")
+	g.printf("}\n\n")
+	for _, group := range groups {
+		if g.generated[group.name] {
+			continue
+		}
+		g.generated[group.name] = true
+		g.writeBind(group)
+	}
+}
+
+func (g *generator) writeMapping(form, model *structType) error {
+	formFields := make(map[string]ast.Expr)
+	for _, field := range form.spec.Fields.List {
+		valueType, ok := g.fieldValueType(field.Type)
+		if !ok {
+			continue
+		}
+		for _, name := range field.Names {
+			formFields[name.Name] = valueType
+		}
+	}
+	g.printf("func %s(src *%s) %s {\n", getMappingName(form.name, model.name), form.name, model.name)
+	g.printf("var result %s\n", model.name)
+	for _, field := range model.spec.Fields.List {
+		for _, name := range field.Names {
+			valueType, ok := formFields[name.Name]
+			if !ok {
+				continue
+			}
+			src := "src." + name.Name
+			dst := "result." + name.Name
+			pointer, ok := field.Type.(*ast.StarExpr)
+			if !ok {
+				if err := g.writeAssign(dst, src+".Value", valueType, field.Type, form.file, model.file, 0); err != nil {
+					return err
+				}
+				continue
+			}
+			g.printf("if %s.Present {\n", src)
+			g.printf("var value %s\n", g.typeString(pointer.X, model.file))
+			if err := g.writeAssign("value", src+".Value", valueType, pointer.X, form.file, model.file, 0); err != nil {
+				return err
+			}
+			g.printf("%s = &value\n", dst)
+			g.printf("}\n")
+		}
+	}
+	g.printf("return result\n")
+	g.printf("}\n\n")
+	return nil
+}
+
+func (g *generator) writeAssign(dst, src string, from, to ast.Expr, fromFile, toFile *ast.File, depth int) error {
+	fromType := g.typeString(from, fromFile)
+	toType := g.typeString(to, toFile)
+	if fromType == toType {
+		g.printf("%s = %s\n", dst, src)
+		return nil
+	}
+	if fromType == g.qualify("Multipart") && toType == "string" {
+		g.printf("%s = %s.Stored()\n", dst, src)
+		return nil
+	}
+	if group, ok := g.groupStruct(from); ok {
+		if ident, ok := to.(*ast.Ident); ok && g.structs[ident.Name] != nil {
+			g.mappings = append(g.mappings, spec{form: group.name, model: ident.Name})
+			g.printf("%s = %s(&%s)\n", dst, getMappingName(group.name, ident.Name), src)
+			return nil
+		}
+	}
+	fromArray, fromOk := from.(*ast.ArrayType)
+	toArray, toOk := to.(*ast.ArrayType)
+	if fromOk && toOk && fromArray.Len == nil && toArray.Len == nil {
+		index := fmt.Sprintf("i%d", depth)
+		g.printf("%s = make(%s, len(%s))\n", dst, toType, src)
+		g.printf("for %s := range %s {\n", index, src)
+		if err := g.writeAssign(dst+"["+index+"]", src+"["+index+"]", fromArray.Elt, toArray.Elt, fromFile, toFile, depth+1); err != nil {
+			return err
+		}
+		g.printf("}\n")
+		return nil
+	}
+	if !g.isConvertible(from, to) {
+		return fmt.Errorf("error while mapping %s: cannot convert %s to %s", src, fromType, toType)
+	}
+	g.printf("%s = %s(%s)\n", dst, toType, src)
+	return nil
+}
+
+func (g *generator) isConvertible(from, to ast.Expr) bool {
+	fromBasic, ok := g.basicType(from)
+	if !ok {
+		return false
+	}
+	toBasic, ok := g.basicType(to)
+	if !ok {
+		return false
+	}
+	if fromBasic.Kind() == toBasic.Kind() {
+		return true
+	}
+	return fromBasic.Info()&types.IsInteger != 0 && toBasic.Info()&types.IsInteger != 0
+}
+
+func (g *generator) basicType(expr ast.Expr) (*types.Basic, bool) {
+	ident, ok := expr.(*ast.Ident)
+	if !ok {
+		return nil, false
+	}
+	if underlying, ok := g.types[ident.Name]; ok {
+		return g.basicType(underlying)
+	}
+	object := types.Universe.Lookup(ident.Name)
+	if object == nil {
+		return nil, false
+	}
+	basic, ok := object.Type().(*types.Basic)
+	return basic, ok
+}
+
+func (g *generator) fieldValueType(expr ast.Expr) (ast.Expr, bool) {
+	index, ok := expr.(*ast.IndexExpr)
+	if !ok || !g.isFormType(index.X, fieldTypeName) {
+		return nil, false
+	}
+	return index.Index, true
+}
+
+func (g *generator) embedsForm(st *structType) bool {
+	for _, field := range st.spec.Fields.List {
+		if len(field.Names) == 0 && g.isFormType(field.Type, formTypeName) {
+			return true
+		}
+	}
+	return false
+}
+
+func (g *generator) groupStruct(expr ast.Expr) (*structType, bool) {
+	ident, ok := expr.(*ast.Ident)
+	if !ok {
+		return nil, false
+	}
+	st, ok := g.structs[ident.Name]
+	if !ok {
+		return nil, false
+	}
+	for _, field := range st.spec.Fields.List {
+		if _, ok := g.fieldValueType(field.Type); ok {
+			return st, true
+		}
+	}
+	return nil, false
+}
+
+func (g *generator) isFormType(expr ast.Expr, name string) bool {
+	switch t := expr.(type) {
+	case *ast.Ident:
+		return len(g.qualifier) == 0 && t.Name == name
+	case *ast.SelectorExpr:
+		pkg, ok := t.X.(*ast.Ident)
+		return ok && pkg.Name == g.qualifier && t.Sel.Name == name
+	}
+	return false
+}
+
+func (g *generator) qualify(name string) string {
+	if len(g.qualifier) == 0 {
+		return name
+	}
+	return g.qualifier + "." + name
+}
+
+func (g *generator) typeString(expr ast.Expr, file *ast.File) string {
+	ast.Inspect(
+		expr, func(node ast.Node) bool {
+			selector, ok := node.(*ast.SelectorExpr)
+			if !ok {
+				return true
+			}
+			if pkg, ok := selector.X.(*ast.Ident); ok {
+				if path, ok := getImportPath(file, pkg.Name); ok {
+					g.imports[pkg.Name] = path
+				}
+			}
+			return false
+		},
+	)
+	return types.ExprString(expr)
+}
+
+func (g *generator) printf(format string, args ...any) {
+	fmt.Fprintf(&g.buf, format, args...)
+}
+
+func getFormQualifier(file *ast.File, pkg string) string {
+	for _, spec := range file.Imports {
+		path, _ := strconv.Unquote(spec.Path.Value)
+		if path != formImportPath {
+			continue
+		}
+		if spec.Name != nil {
+			return spec.Name.Name
+		}
+		return formPkgName
+	}
+	if pkg == formPkgName {
+		return ""
+	}
+	return formPkgName
+}
+
+func getImportPath(file *ast.File, name string) (string, bool) {
+	for _, spec := range file.Imports {
+		path, _ := strconv.Unquote(spec.Path.Value)
+		if spec.Name != nil && spec.Name.Name == name || spec.Name == nil && filepath.Base(path) == name {
+			return path, true
+		}
+	}
+	return "", false
+}
+
+func getBindName(name string) string {
+	return "bind" + strcase.ToCamel(name)
+}
+
+func getMappingName(form, model string) string {
+	return "Create" + strcase.ToCamel(model) + "From" + strcase.ToCamel(form)
+}
@@ -0,0 +1,173 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	testSource = `package users
+
+import (
+	"time"
+
+	f "github.com/creamsensation/form"
+)
+
+type UserForm struct {
+	f.Form
+	Name     f.Field[string]
+	Born     f.Field[time.Time]
+	Age      f.Field[int]
+	Avatar   f.Field[f.Multipart]
+	Tags     f.Field[[]string]
+	Address  f.Field[AddressForm]
+	Contacts f.Field[[]ContactForm]
+	Note     string
+}
+
+type AddressForm struct {
+	Street f.Field[string]
+}
+
+type ContactForm struct {
+	Email f.Field[string]
+}
+
+type User struct {
+	Name     string
+	Born     *time.Time
+	Age      int64
+	Avatar   string
+	Tags     []string
+	Address  Address
+	Contacts []Contact
+}
+
+type Address struct {
+	Street string
+}
+
+type Contact struct {
+	Email string
+}
+`
+)
+
+func TestGenerate(t *testing.T) {
+	dir := t.TempDir()
+	assert.Nil(t, os.WriteFile(filepath.Join(dir, "user.go"), []byte(testSource), 0644))
+	t.Run(
+		"build and mapping", func(t *testing.T) {
+			src, err := generate(dir, "user-form-gen.go", []spec{{form: "UserForm", model: "User"}})
+			assert.Nil(t, err)
+			code := string(src)
+			assert.Contains(t, code, "// Code generated by formgen. DO NOT EDIT.")
+			assert.Contains(t, code, "package users")
+			assert.Contains(t, code, "f \"github.com/creamsensation/form\"")
+			assert.Contains(t, code, "\"time\"")
+			assert.Contains(t, code, "func BuildUserForm(b *f.Builder) (UserForm, error) {")
+			assert.Contains(t, code, "result.Born, err = f.BindField[time.Time](binder)")
+			assert.Contains(t, code, "result.Address, err = f.BindGroup(binder, bindAddressForm)")
+			assert.Contains(t, code, "result.Contacts, err = f.BindGroups(binder, bindContactForm)")
+			assert.Contains(t, code, "func bindContactForm(binder *f.Binder) (ContactForm, error) {")
+			assert.Contains(t, code, "result.Form = binder.Form()")
+			assert.NotContains(t, code, "\"Note\"")
+			assert.Contains(t, code, "func CreateUserFromUserForm(src *UserForm) User {")
+			assert.Contains(t, code, "if src.Born.Present {")
+			assert.Contains(t, code, "result.Age = int64(src.Age.Value)")
+			assert.Contains(t, code, "result.Avatar = src.Avatar.Value.Stored()")
+			assert.Contains(t, code, "result.Address = CreateAddressFromAddressForm(&src.Address.Value)")
+			assert.Contains(t, code, "result.Contacts[i0] = CreateContactFromContactForm(&src.Contacts.Value[i0])")
+		},
+	)
+	t.Run(
+		"errors", func(t *testing.T) {
+			_, err := generate(dir, "", []spec{{form: "MissingForm"}})
+			assert.Error(t, err)
+			_, err = generate(dir, "", []spec{{form: "AddressForm"}})
+			assert.Error(t, err)
+			_, err = generate(dir, "", []spec{{form: "UserForm", model: "Missing"}})
+			assert.Error(t, err)
+			_, err = parseSpecs("")
+			assert.Error(t, err)
+		},
+	)
+}
+
+func TestGenerateConversion(t *testing.T) {
+	createSource := func(model string) string {
+		return `package orders
+
+import "github.com/creamsensation/form"
+
+type Quantity int
+
+type Code string
+
+type OrderForm struct {
+	form.Form
+	Count form.Field[int]
+	Price form.Field[float64]
+	Code  form.Field[Code]
+	Ids   form.Field[[]int64]
+}
+
+type Order struct {
+` + model + `
+}
+`
+	}
+	generateModel := func(t *testing.T, model string) (string, error) {
+		dir := t.TempDir()
+		assert.Nil(t, os.WriteFile(filepath.Join(dir, "order.go"), []byte(createSource(model)), 0644))
+		src, err := generate(dir, "", []spec{{form: "OrderForm", model: "Order"}})
+		return string(src), err
+	}
+	t.Run(
+		"integer and same kind", func(t *testing.T) {
+			code, err := generateModel(t, "Count Quantity\nPrice float64\nCode string\nIds []uint")
+			assert.Nil(t, err)
+			assert.Contains(t, code, "result.Count = Quantity(src.Count.Value)")
+			assert.Contains(t, code, "result.Price = src.Price.Value")
+			assert.Contains(t, code, "result.Code = string(src.Code.Value)")
+			assert.Contains(t, code, "result.Ids[i0] = uint(src.Ids.Value[i0])")
+		},
+	)
+	t.Run(
+		"int to string", func(t *testing.T) {
+			_, err := generateModel(t, "Count string")
+			assert.ErrorContains(t, err, "cannot convert int to string")
+		},
+	)
+	t.Run(
+		"float to int", func(t *testing.T) {
+			_, err := generateModel(t, "Price int")
+			assert.ErrorContains(t, err, "cannot convert float64 to int")
+		},
+	)
+	t.Run(
+		"slice and named types", func(t *testing.T) {
+			_, err := generateModel(t, "Ids []string")
+			assert.ErrorContains(t, err, "cannot convert int64 to string")
+			_, err = generateModel(t, "Code Quantity")
+			assert.ErrorContains(t, err, "cannot convert Code to Quantity")
+		},
+	)
+}
+
+func TestGenerateRepository(t *testing.T) {
+	specs, err := parseSpecs(
+		"testForm=testModel,testForm=testOptionalModel,testInvoiceForm=testInvoiceModel,testCustomForm=testCustomModel," +
+			"testEnumForm=testEnumModel,testNumberForm,testMoneyForm,testCheckboxForm,testBenchmarkForm",
+	)
+	assert.Nil(t, err)
+	src, err := generate("../..", "generated_test.go", specs)
+	assert.Nil(t, err)
+	expected, err := os.ReadFile("../../generated_test.go")
+	assert.Nil(t, err)
+	assert.Equal(t, string(expected), string(src))
+}
@@ -0,0 +1,143 @@
+package form
+
+import (
+	"encoding"
+	"fmt"
+	"reflect"
+	"sync"
+	"time"
+)
+
+type converter struct {
+	parse  func(string) (any, error)
+	format func(any) string
+}
+
+var (
+	converters   = make(map[reflect.Type]converter)
+	convertersMu sync.RWMutex
+)
+
+var (
+	textUnmarshalerType = reflect.TypeOf((*encoding.TextUnmarshaler)(nil)).Elem()
+)
+
+func RegisterConverter[T any](parse func(string) (T, error), format func(T) string) {
+	convertersMu.Lock()
+	defer convertersMu.Unlock()
+	converters[reflect.TypeOf((*T)(nil)).Elem()] = converter{
+		parse: func(value string) (any, error) {
+			return parse(value)
+		},
+		format: func(value any) string {
+			return format(value.(T))
+		},
+	}
+}
+
+func Value[T any](value ...T) FieldConfig {
+	return FieldConfig{
+		fieldType: fieldTypeText,
+		dataType:  fieldDataTypeCustom,
+		value:     value,
+	}
+}
+
+func (f Field[T]) String() string {
+	values := f.Values()
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
+
+func (f Field[T]) Values() []string {
+	v := reflect.ValueOf(f.Value)
+	if v.Kind() != reflect.Slice || v.Type() == reflect.TypeOf([]byte(nil)) {
+		return []string{formatValue(f.Value)}
+	}
+	result := make([]string, v.Len())
+	for i := range result {
+		result[i] = formatValue(v.Index(i).Interface())
+	}
+	return result
+}
+
+func getConverter(t reflect.Type) (converter, bool) {
+	convertersMu.RLock()
+	defer convertersMu.RUnlock()
+	c, ok := converters[t]
+	return c, ok
+}
+
+func parseCustomValue(fb *FieldBuilder, values []string) (any, error) {
+	t := reflect.TypeOf(fb.value)
+	if !fb.multiple {
+		v, err := parseCustom(t, values[0])
+		return v.Interface(), err
+	}
+	var err error
+	result := reflect.MakeSlice(t, len(values), len(values))
+	for i, value := range values {
+		v, parseErr := parseCustom(t.Elem(), value)
+		if parseErr != nil && err == nil {
+			err = parseErr
+		}
+		result.Index(i).Set(v)
+	}
+	return result.Interface(), err
+}
+
+func parseCustom(t reflect.Type, value string) (reflect.Value, error) {
+	result := reflect.New(t).Elem()
+	if len(value) == 0 {
+		return result, nil
+	}
+	if c, ok := getConverter(t); ok {
+		v, err := c.parse(value)
+		if err != nil {
+			return result, err
+		}
+		result.Set(reflect.ValueOf(v))
+		return result, nil
+	}
+	if reflect.PointerTo(t).Implements(textUnmarshalerType) {
+		if err := result.Addr().Interface().(encoding.TextUnmarshaler).UnmarshalText([]byte(value)); err != nil {
+			return reflect.New(t).Elem(), err
+		}
+		return result, nil
+	}
+	if t.Kind() == reflect.String {
+		result.SetString(value)
+		return result, nil
+	}
+	if len(getNumberDataType(result.Interface())) > 0 {
+		return parseNumber(t, value)
+	}
+	return result, fmt.Errorf("missing converter for type %s", t)
+}
+
+func formatValue(value any) string {
+	if value == nil {
+		return ""
+	}
+	if c, ok := getConverter(reflect.TypeOf(value)); ok {
+		return c.format(value)
+	}
+	if t, ok := value.(time.Time); ok {
+		return t.Format(fieldTimeFormat)
+	}
+	ref := reflect.New(reflect.TypeOf(value))
+	ref.Elem().Set(reflect.ValueOf(value))
+	if marshaler, ok := ref.Interface().(encoding.TextMarshaler); ok {
+		text, err := marshaler.MarshalText()
+		if err != nil {
+			return ""
+		}
+		return string(text)
+	}
+	if stringer, ok := value.(fmt.Stringer); ok {
+		return stringer.String()
+	}
+	return fmt.Sprintf("%v", value)
+}
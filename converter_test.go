@@ -0,0 +1,85 @@
+package form
+
+import (
+	"fmt"
+	"net/url"
+	"testing"
+	
+	"github.com/stretchr/testify/assert"
+)
+
+func TestConverter(t *testing.T) {
+	RegisterConverter[testUserId](
+		func(value string) (testUserId, error) {
+			var id testUserId
+			_, err := fmt.Sscanf(value, "u-%d", &id)
+			return id, err
+		},
+		func(id testUserId) string {
+			return fmt.Sprintf("u-%d", id)
+		},
+	)
+	t.Cleanup(unregisterConverter[testUserId])
+	t.Run(
+		"parse", func(t *testing.T) {
+			req := testCreateValuesRequest(
+				url.Values{
+					"owner":    {"u-42"},
+					"color":    {"#00ff10"},
+					"slug":     {"hello-world"},
+					"tags":     {"a", "b"},
+					"quantity": {"3"},
+				},
+			)
+			form, err := Build[testCustomForm](testCreateCustomBuilder().Request(req))
+			assert.Nil(t, err)
+			assert.True(t, form.Valid)
+			assert.Equal(t, testUserId(42), form.Owner.Value)
+			assert.Equal(t, testColor{G: 255, B: 16}, form.Color.Value)
+			assert.Equal(t, testSlug("hello-world"), form.Slug.Value)
+			assert.Equal(t, []testSlug{"a", "b"}, form.Tags.Value)
+			assert.Equal(t, testQuantity(3), form.Quantity.Value)
+		},
+	)
+	t.Run(
+		"format", func(t *testing.T) {
+			form, err := Build[testCustomForm](testCreateCustomBuilder())
+			assert.Nil(t, err)
+			assert.Equal(t, "u-0", form.Owner.String())
+			assert.Equal(t, "#ff0000", form.Color.String())
+			assert.Equal(t, []string{}, form.Tags.Values())
+			form.Tags.Value = []testSlug{"a", "b"}
+			assert.Equal(t, []string{"a", "b"}, form.Tags.Values())
+		},
+	)
+	t.Run(
+		"invalid", func(t *testing.T) {
+			req := testCreateValuesRequest(url.Values{"owner": {"42"}, "color": {"red"}})
+			form, err := Build[testCustomForm](testCreateCustomBuilder().Request(req))
+			assert.Nil(t, err)
+			assert.False(t, form.Valid)
+			assert.Equal(t, []string{defaultInvalidMessage}, form.Owner.Messages)
+			assert.Equal(t, []string{defaultInvalidMessage}, form.Color.Messages)
+		},
+	)
+	t.Run(
+		"required", func(t *testing.T) {
+			req := testCreateValuesRequest(url.Values{"owner": {""}})
+			form, err := Build[testCustomForm](testCreateCustomBuilder().Request(req))
+			assert.Nil(t, err)
+			assert.Equal(t, []string{defaultRequiredMessage}, form.Owner.Messages)
+		},
+	)
+	t.Run(
+		"create struct", func(t *testing.T) {
+			req := testCreateValuesRequest(url.Values{"owner": {"u-7"}, "color": {"#010203"}, "slug": {"abc"}})
+			form, err := Build[testCustomForm](testCreateCustomBuilder().Request(req))
+			assert.Nil(t, err)
+			assert.Equal(
+				t,
+				testCustomModel{Owner: 7, Color: testColor{R: 1, G: 2, B: 3}, Slug: "abc"},
+				CreateStruct[testCustomForm, testCustomModel](&form),
+			)
+		},
+	)
+}
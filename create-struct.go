@@ -5,17 +5,23 @@ import (
 )
 
 const (
-	valueFieldName = "Value"
+	valueFieldName   = "Value"
+	presentFieldName = "Present"
 )
 
 func CreateStruct[S, R any](src *S) R {
 	result := new(R)
 	srcRef := reflect.ValueOf(src)
 	resultRef := reflect.ValueOf(result)
-	for i := 0; i < resultRef.Elem().NumField(); i++ {
-		resultField := resultRef.Elem().Field(i)
-		resultFieldName := resultRef.Elem().Type().Field(i).Name
-		srcField := srcRef.Elem().FieldByName(resultFieldName)
+	fillStruct(srcRef.Elem(), resultRef.Elem())
+	return *result
+}
+
+func fillStruct(src, result reflect.Value) {
+	for i := 0; i < result.NumField(); i++ {
+		resultField := result.Field(i)
+		resultFieldName := result.Type().Field(i).Name
+		srcField := src.FieldByName(resultFieldName)
 		if srcField.Kind() != reflect.Struct {
 			continue
 		}
@@ -23,7 +29,39 @@ func CreateStruct[S, R any](src *S) R {
 		if !valueField.IsValid() {
 			continue
 		}
-		resultField.Set(valueField)
+		if resultField.Kind() == reflect.Ptr && valueField.Kind() != reflect.Ptr {
+			setStructPointer(valueField, resultField, srcField.FieldByName(presentFieldName))
+			continue
+		}
+		setStructValue(valueField, resultField)
+	}
+}
+
+func setStructPointer(value, target, present reflect.Value) {
+	if present.IsValid() && !present.Bool() {
+		target.Set(reflect.Zero(target.Type()))
+		return
+	}
+	ptr := reflect.New(target.Type().Elem())
+	setStructValue(value, ptr.Elem())
+	target.Set(ptr)
+}
+
+func setStructValue(value, target reflect.Value) {
+	switch {
+	case value.Type().AssignableTo(target.Type()):
+		target.Set(value)
+	case value.Type() == reflect.TypeOf(Multipart{}) && target.Kind() == reflect.String:
+		target.SetString(value.Interface().(Multipart).Stored())
+	case value.Kind() == reflect.Struct && target.Kind() == reflect.Struct:
+		fillStruct(value, target)
+	case value.Kind() == reflect.Slice && target.Kind() == reflect.Slice:
+		items := reflect.MakeSlice(target.Type(), value.Len(), value.Len())
+		for i := 0; i < value.Len(); i++ {
+			setStructValue(value.Index(i), items.Index(i))
+		}
+		target.Set(items)
+	case value.Kind() == target.Kind() && value.Type().ConvertibleTo(target.Type()):
+		target.Set(value.Convert(target.Type()))
 	}
-	return *result
 }
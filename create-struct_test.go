@@ -1,6 +1,7 @@
 package form
 
 import (
+	"net/url"
 	"testing"
 	
 	"github.com/stretchr/testify/assert"
@@ -31,4 +32,20 @@ func TestCreateStruct(t *testing.T) {
 			)
 		},
 	)
+	t.Run(
+		"optional", func(t *testing.T) {
+			req := testCreateValuesRequest(url.Values{"quantity": {"0"}, "amount": {""}})
+			form, err := Build[testForm](
+				New(
+					Add("quantity").With(Number[int]()),
+					Add("amount").With(Number[float64]()),
+				).Request(req),
+			)
+			assert.Nil(t, err)
+			result := CreateStruct[testForm, testOptionalModel](&form)
+			assert.NotNil(t, result.Quantity)
+			assert.Equal(t, 0, *result.Quantity)
+			assert.Nil(t, result.Amount)
+		},
+	)
 }
@@ -0,0 +1,149 @@
+package form
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"strings"
+)
+
+type Decimal struct {
+	coefficient *big.Int
+	scale       int
+}
+
+var (
+	errInvalidDecimal = errors.New("invalid decimal")
+)
+
+func NewDecimal(value int64, scale int) Decimal {
+	if scale < 0 {
+		return Decimal{coefficient: new(big.Int).Mul(big.NewInt(value), pow10(-scale))}
+	}
+	return Decimal{coefficient: big.NewInt(value), scale: scale}
+}
+
+func ParseDecimal(value string) (Decimal, error) {
+	s := strings.TrimSpace(value)
+	sign := ""
+	if len(s) > 0 && (s[0] == '-' || s[0] == '+') {
+		sign, s = s[:1], s[1:]
+	}
+	whole, fraction, _ := strings.Cut(s, ".")
+	if len(whole)+len(fraction) == 0 || !isDigits(whole) || !isDigits(fraction) {
+		return Decimal{}, fmt.Errorf("%w: %s", errInvalidDecimal, value)
+	}
+	coefficient, ok := new(big.Int).SetString(sign+whole+fraction, 10)
+	if !ok {
+		return Decimal{}, fmt.Errorf("%w: %s", errInvalidDecimal, value)
+	}
+	return Decimal{coefficient: coefficient, scale: len(fraction)}, nil
+}
+
+func MustParseDecimal(value string) Decimal {
+	d, err := ParseDecimal(value)
+	if err != nil {
+		panic(err)
+	}
+	return d
+}
+
+func (d Decimal) Scale() int {
+	return d.scale
+}
+
+func (d Decimal) Sign() int {
+	return d.int().Sign()
+}
+
+func (d Decimal) IsZero() bool {
+	return d.Sign() == 0
+}
+
+func (d Decimal) Cmp(other Decimal) int {
+	return d.Rat().Cmp(other.Rat())
+}
+
+func (d Decimal) Equal(other Decimal) bool {
+	return d.Cmp(other) == 0
+}
+
+func (d Decimal) Normalize() Decimal {
+	coefficient := new(big.Int).Set(d.int())
+	scale := d.scale
+	ten := big.NewInt(10)
+	remainder := new(big.Int)
+	for scale > 0 {
+		quotient, r := new(big.Int).QuoRem(coefficient, ten, remainder)
+		if r.Sign() != 0 {
+			break
+		}
+		coefficient = quotient
+		scale--
+	}
+	return Decimal{coefficient: coefficient, scale: scale}
+}
+
+func (d Decimal) Rat() *big.Rat {
+	return new(big.Rat).SetFrac(d.int(), pow10(d.scale))
+}
+
+func (d Decimal) Float64() float64 {
+	f, _ := d.Rat().Float64()
+	return f
+}
+
+func (d Decimal) String() string {
+	digits := new(big.Int).Abs(d.int()).String()
+	if d.scale > 0 {
+		if len(digits) <= d.scale {
+			digits = strings.Repeat("0", d.scale-len(digits)+1) + digits
+		}
+		digits = digits[:len(digits)-d.scale] + "." + digits[len(digits)-d.scale:]
+	}
+	if d.Sign() < 0 {
+		return "-" + digits
+	}
+	return digits
+}
+
+func (d Decimal) MarshalText() ([]byte, error) {
+	return []byte(d.String()), nil
+}
+
+func (d *Decimal) UnmarshalText(text []byte) error {
+	parsed, err := ParseDecimal(string(text))
+	if err != nil {
+		return err
+	}
+	*d = parsed
+	return nil
+}
+
+func (d Decimal) rescale(scale int) Decimal {
+	if scale <= d.scale {
+		return d
+	}
+	coefficient := new(big.Int).Mul(d.int(), pow10(scale-d.scale))
+	return Decimal{coefficient: coefficient, scale: scale}
+}
+
+func (d Decimal) int() *big.Int {
+	if d.coefficient == nil {
+		return new(big.Int)
+	}
+	return d.coefficient
+}
+
+func pow10(exponent int) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(exponent)), nil)
+}
+
+func isDigits(value string) bool {
+	for _, r := range value {
+		if r < '0' || r > '9' {
+			return false
+		}
+	}
+	return true
+}
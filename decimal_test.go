@@ -0,0 +1,64 @@
+package form
+
+import (
+	"testing"
+	
+	"github.com/stretchr/testify/assert"
+)
+
+func TestDecimal(t *testing.T) {
+	t.Run(
+		"parse", func(t *testing.T) {
+			for value, expected := range map[string]string{
+				"10":     "10",
+				"10.50":  "10.50",
+				"-0.05":  "-0.05",
+				"+.5":    "0.5",
+				"7.":     "7",
+				" 1.25 ": "1.25",
+			} {
+				d, err := ParseDecimal(value)
+				assert.Nil(t, err)
+				assert.Equal(t, expected, d.String())
+			}
+			for _, value := range []string{"", ".", "-", "1.2.3", "1e3", "abc", "1,5"} {
+				_, err := ParseDecimal(value)
+				assert.ErrorIs(t, err, errInvalidDecimal)
+			}
+		},
+	)
+	t.Run(
+		"create", func(t *testing.T) {
+			assert.Equal(t, "-0.05", NewDecimal(-5, 2).String())
+			assert.Equal(t, "1200", NewDecimal(12, -2).String())
+			assert.Equal(t, "0", Decimal{}.String())
+			assert.True(t, Decimal{}.IsZero())
+		},
+	)
+	t.Run(
+		"compare", func(t *testing.T) {
+			assert.True(t, MustParseDecimal("10.10").Equal(MustParseDecimal("10.1")))
+			assert.Equal(t, -1, MustParseDecimal("0.1").Cmp(MustParseDecimal("0.11")))
+			assert.Equal(t, 1, MustParseDecimal("-1").Cmp(MustParseDecimal("-1.5")))
+			assert.Equal(t, -1, MustParseDecimal("-3").Sign())
+		},
+	)
+	t.Run(
+		"normalize", func(t *testing.T) {
+			d := MustParseDecimal("10.500").Normalize()
+			assert.Equal(t, "10.5", d.String())
+			assert.Equal(t, 1, d.Scale())
+			assert.Equal(t, "100", MustParseDecimal("100.00").Normalize().String())
+		},
+	)
+	t.Run(
+		"text", func(t *testing.T) {
+			var d Decimal
+			assert.Nil(t, d.UnmarshalText([]byte("-12.340")))
+			text, err := d.MarshalText()
+			assert.Nil(t, err)
+			assert.Equal(t, "-12.340", string(text))
+			assert.NotNil(t, d.UnmarshalText([]byte("x")))
+		},
+	)
+}
@@ -0,0 +1,63 @@
+package form
+
+import (
+	"github.com/creamsensation/gox"
+)
+
+type EnumOption[T comparable] struct {
+	Value   T
+	Label   string
+	Checked bool
+}
+
+func Enum[T comparable](options ...EnumOption[T]) FieldConfig {
+	value := make([]T, 0)
+	enum := make([]any, len(options))
+	fieldOptions := make([]Option, len(options))
+	for i, option := range options {
+		enum[i] = option.Value
+		fieldOptions[i] = Option{
+			Value: formatValue(option.Value),
+			Label: option.Label,
+		}
+		if len(option.Label) == 0 {
+			fieldOptions[i].Label = fieldOptions[i].Value
+		}
+		if option.Checked {
+			value = append(value, option.Value)
+		}
+	}
+	return FieldConfig{
+		fieldType: fieldTypeSelect,
+		dataType:  fieldDataTypeEnum,
+		value:     value,
+		options:   fieldOptions,
+		enum:      enum,
+	}
+}
+
+func SelectNode[T any](field Field[T], nodes ...gox.Node) gox.Node {
+	options := make([]gox.Node, len(field.Options))
+	for i, option := range field.Options {
+		options[i] = gox.Option(
+			gox.Value(option.Value),
+			gox.If(option.Checked, gox.Attribute("selected", "selected")),
+			gox.Text(option.Label),
+		)
+	}
+	return gox.Select(
+		gox.Id(field.InputId()),
+		gox.Name(field.Name),
+		gox.If(field.Multiple, gox.Attribute("multiple", "multiple")),
+		gox.If(field.Required, gox.Attribute("required", "required")),
+		gox.If(field.Disabled, gox.Attribute("disabled", "disabled")),
+		gox.If(field.Autofocus, gox.Attribute("autofocus", "autofocus")),
+		field.Aria(),
+		gox.Fragment(nodes...),
+		gox.Fragment(options...),
+	)
+}
+
+func RadioNode[T any](field Field[T], nodes ...gox.Node) gox.Node {
+	return createOptionsNode(fieldTypeRadio, field.base(), nodes...)
+}
@@ -0,0 +1,67 @@
+package form
+
+import (
+	"net/url"
+	"testing"
+	
+	"github.com/creamsensation/gox"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestEnum(t *testing.T) {
+	t.Run(
+		"default", func(t *testing.T) {
+			form, err := Build[testEnumForm](testCreateEnumBuilder())
+			assert.Nil(t, err)
+			assert.Equal(t, testStatusDraft, form.Status.Value)
+			assert.Equal(t, testLevel(0), form.Level.Value)
+			assert.Equal(t, fieldTypeSelect, form.Status.Type)
+			assert.Equal(t, "draft", form.Tags.Options[0].Label)
+		},
+	)
+	t.Run(
+		"parse", func(t *testing.T) {
+			req := testCreateValuesRequest(
+				url.Values{"status": {"published"}, "level": {"2"}, "tags": {"draft", "published"}},
+			)
+			form, err := Build[testEnumForm](testCreateEnumBuilder().Request(req))
+			assert.Nil(t, err)
+			assert.True(t, form.Valid)
+			assert.Equal(t, testStatusPublished, form.Status.Value)
+			assert.Equal(t, testLevel(2), form.Level.Value)
+			assert.Equal(t, []testStatus{testStatusDraft, testStatusPublished}, form.Tags.Value)
+			assert.Equal(
+				t,
+				testEnumModel{Status: testStatusPublished, Level: 2},
+				CreateStruct[testEnumForm, testEnumModel](&form),
+			)
+		},
+	)
+	t.Run(
+		"reject", func(t *testing.T) {
+			req := testCreateValuesRequest(url.Values{"status": {"archived"}, "level": {""}})
+			form, err := Build[testEnumForm](testCreateEnumBuilder().Request(req))
+			assert.Nil(t, err)
+			assert.False(t, form.Valid)
+			assert.Equal(t, testStatus(""), form.Status.Value)
+			assert.Equal(t, []string{defaultInvalidMessage}, form.Status.Messages)
+			assert.Equal(t, []string{defaultRequiredMessage}, form.Level.Messages)
+		},
+	)
+	t.Run(
+		"render", func(t *testing.T) {
+			req := testCreateValuesRequest(url.Values{"status": {"published"}, "level": {"1"}})
+			form, err := Build[testEnumForm](testCreateEnumBuilder().Request(req))
+			assert.Nil(t, err)
+			selectNode := gox.Render(SelectNode(form.Status, gox.Option(gox.Value(""), gox.Text("-"))))
+			assert.Contains(t, selectNode, `<select id="status" name="status" required="required" aria-required="true">`)
+			assert.Contains(t, selectNode, `<option value="published" selected="selected">Published</option>`)
+			assert.Contains(t, selectNode, `<option value="draft">Draft</option>`)
+			radio := gox.Render(RadioNode(form.Level))
+			assert.Contains(t, radio, `<input type="radio" name="level" value="1" checked="checked" />`)
+			assert.Contains(t, radio, `High`)
+			checkboxes := gox.Render(CheckboxGroupNode(form.Tags))
+			assert.Contains(t, checkboxes, `<input type="checkbox" name="tags" value="draft" />`)
+		},
+	)
+}
@@ -2,32 +2,53 @@ package form
 
 import (
 	"fmt"
+	"reflect"
 	"time"
 	
 	"golang.org/x/exp/constraints"
 )
 
 type FieldBuilder struct {
-	dataType   string
-	fieldType  string
-	id         string
-	autofocus  bool
-	disabled   bool
-	multiple   bool
-	valid      bool
-	name       string
-	label      string
-	text       string
-	size       int
-	value      any
-	validators []validator
-	messages   Messages
+	dataType     string
+	fieldType    string
+	id           string
+	autofocus    bool
+	focus        bool
+	disabled     bool
+	multiple     bool
+	valid        bool
+	present      bool
+	invalid      bool
+	name         string
+	label        string
+	text         string
+	size         int
+	path         string
+	value        any
+	group        *fieldGroup
+	storage      Storage
+	uploads      *UploadHandler
+	stash        *FileStash
+	checkValue   string
+	policy       *SanitizePolicy
+	options      []Option
+	enum         []any
+	validators   []validator
+	transformers []Transformer
+	messages     Messages
+	errors       []string
+	theme        *Theme
 }
 
 type FieldConfig struct {
 	fieldType string
 	dataType  string
 	value     any
+	fields    []*FieldBuilder
+	policy    *SanitizePolicy
+	options   []Option
+	enum      []any
+	multiple  bool
 }
 
 const (
@@ -38,15 +59,19 @@ const (
 	fieldTypeDateTimeLocal = "datetime-local"
 	fieldTypeEmail         = "email"
 	fieldTypeFile          = "file"
+	fieldTypeGroup         = "group"
 	fieldTypeHidden        = "hidden"
 	fieldTypeImage         = "image"
+	fieldTypeMoney         = "money"
 	fieldTypeMonth         = "month"
 	fieldTypeNumber        = "number"
 	fieldTypePassword      = "password"
 	fieldTypeRadio         = "radio"
 	fieldTypeRange         = "range"
 	fieldTypeReset         = "reset"
+	fieldTypeRichText      = "richtext"
 	fieldTypeSearch        = "search"
+	fieldTypeSelect        = "select"
 	fieldTypeSubmit        = "submit"
 	fieldTypeTel           = "tel"
 	fieldTypeText          = "text"
@@ -54,12 +79,18 @@ const (
 	fieldTypeUrl           = "url"
 	fieldTypeWeek          = "week"
 	
-	fieldDataTypeBool   = "bool"
-	fieldDataTypeFile   = "file"
-	fieldDataTypeFloat  = "float"
-	fieldDataTypeInt    = "int"
-	fieldDataTypeString = "string"
-	fieldDataTypeTime   = "time"
+	fieldDataTypeBool    = "bool"
+	fieldDataTypeCustom  = "custom"
+	fieldDataTypeDecimal = "decimal"
+	fieldDataTypeEnum    = "enum"
+	fieldDataTypeFile    = "file"
+	fieldDataTypeFloat   = "float"
+	fieldDataTypeGroup   = "group"
+	fieldDataTypeInt     = "int"
+	fieldDataTypeMoney   = "money"
+	fieldDataTypeString  = "string"
+	fieldDataTypeTime    = "time"
+	fieldDataTypeUint    = "uint"
 	
 	fieldTimeFormat = "2006-01-02 15:04:05.999999999 +0000 UTC"
 )
@@ -100,6 +131,13 @@ func (b *FieldBuilder) Text(text any) *FieldBuilder {
 }
 
 func (b *FieldBuilder) With(config FieldConfig, validators ...Validator) *FieldBuilder {
+	if config.multiple {
+		b.multiple = true
+	}
+	if len(config.options) > 0 {
+		b.options = config.options
+		b.enum = config.enum
+	}
 	switch config.value.(type) {
 	case []any:
 		createFieldType[any](b, config.fieldType, config.dataType, config.value.([]any)...)
@@ -107,8 +145,32 @@ func (b *FieldBuilder) With(config FieldConfig, validators ...Validator) *FieldB
 		createFieldType[string](b, config.fieldType, config.dataType, config.value.([]string)...)
 	case []int:
 		createFieldType[int](b, config.fieldType, config.dataType, config.value.([]int)...)
+	case []int8:
+		createFieldType[int8](b, config.fieldType, config.dataType, config.value.([]int8)...)
+	case []int16:
+		createFieldType[int16](b, config.fieldType, config.dataType, config.value.([]int16)...)
+	case []int32:
+		createFieldType[int32](b, config.fieldType, config.dataType, config.value.([]int32)...)
+	case []int64:
+		createFieldType[int64](b, config.fieldType, config.dataType, config.value.([]int64)...)
+	case []uint:
+		createFieldType[uint](b, config.fieldType, config.dataType, config.value.([]uint)...)
+	case []uint8:
+		createFieldType[uint8](b, config.fieldType, config.dataType, config.value.([]uint8)...)
+	case []uint16:
+		createFieldType[uint16](b, config.fieldType, config.dataType, config.value.([]uint16)...)
+	case []uint32:
+		createFieldType[uint32](b, config.fieldType, config.dataType, config.value.([]uint32)...)
+	case []uint64:
+		createFieldType[uint64](b, config.fieldType, config.dataType, config.value.([]uint64)...)
+	case []uintptr:
+		createFieldType[uintptr](b, config.fieldType, config.dataType, config.value.([]uintptr)...)
 	case []float32:
 		createFieldType[float32](b, config.fieldType, config.dataType, config.value.([]float32)...)
+	case []Decimal:
+		createFieldType[Decimal](b, config.fieldType, config.dataType, config.value.([]Decimal)...)
+	case []Amount:
+		createFieldType[Amount](b, config.fieldType, config.dataType, config.value.([]Amount)...)
 	case []float64:
 		createFieldType[float64](b, config.fieldType, config.dataType, config.value.([]float64)...)
 	case []bool:
@@ -117,6 +179,19 @@ func (b *FieldBuilder) With(config FieldConfig, validators ...Validator) *FieldB
 		createFieldType[Multipart](b, config.fieldType, config.dataType, config.value.([]Multipart)...)
 	case []time.Time:
 		createFieldType[time.Time](b, config.fieldType, config.dataType, config.value.([]time.Time)...)
+	default:
+		if values := reflect.ValueOf(config.value); values.Kind() == reflect.Slice {
+			createFieldTypeOf(b, config.fieldType, config.dataType, values)
+		}
+	}
+	if config.dataType == fieldDataTypeGroup {
+		createFieldGroup(b, config.fieldType, config.dataType, config.fields...)
+	}
+	if config.policy != nil {
+		b.policy = config.policy
+	}
+	if b.policy != nil {
+		b.value = sanitizeFieldValue(b.value, b.policy)
 	}
 	for _, v := range validators {
 		b.validators = append(b.validators, v.(validator))
@@ -124,6 +199,29 @@ func (b *FieldBuilder) With(config FieldConfig, validators ...Validator) *FieldB
 	return b
 }
 
+func (b *FieldBuilder) fullName() string {
+	if len(b.path) > 0 {
+		return b.path
+	}
+	return b.name
+}
+
+func (b *FieldBuilder) clone() *FieldBuilder {
+	c := *b
+	if b.group != nil {
+		c.group = b.group.clone()
+	}
+	return &c
+}
+
+func (b *FieldBuilder) isNumber() bool {
+	switch b.dataType {
+	case fieldDataTypeInt, fieldDataTypeUint, fieldDataTypeFloat, fieldDataTypeDecimal, fieldDataTypeMoney:
+		return true
+	}
+	return false
+}
+
 func (b *FieldBuilder) isRequired() bool {
 	for _, v := range b.validators {
 		if v.validatorType == validatorTypeRequired {
@@ -190,12 +288,8 @@ func File(value ...Multipart) FieldConfig {
 }
 
 func Hidden[T comparable](value ...T) FieldConfig {
-	var dataType string
+	dataType := getNumberDataType(*new(T))
 	switch any(*new(T)).(type) {
-	case float32, float64:
-		dataType = fieldDataTypeFloat
-	case int:
-		dataType = fieldDataTypeInt
 	case string:
 		dataType = fieldDataTypeString
 	case bool:
@@ -239,19 +333,10 @@ func (b *FieldBuilder) Multiple(size ...int) *FieldBuilder {
 	return b
 }
 
-func Number[T constraints.Float | constraints.Integer](value ...T) FieldConfig {
-	v := *new(T)
-	switch any(v).(type) {
-	case int:
-		return FieldConfig{
-			fieldType: fieldTypeNumber,
-			dataType:  fieldDataTypeInt,
-			value:     value,
-		}
-	}
+func Number[T constraints.Float | constraints.Integer | Decimal](value ...T) FieldConfig {
 	return FieldConfig{
 		fieldType: fieldTypeNumber,
-		dataType:  fieldDataTypeFloat,
+		dataType:  getNumberDataType(*new(T)),
 		value:     value,
 	}
 }
@@ -365,3 +450,25 @@ func createFieldType[T any](b *FieldBuilder, fieldType, dataType string, values
 		}
 	}
 }
+
+func createFieldTypeOf(b *FieldBuilder, fieldType, dataType string, values reflect.Value) {
+	b.fieldType = fieldType
+	b.dataType = dataType
+	if !b.multiple {
+		b.multiple = values.Len() > 1
+	}
+	if b.multiple {
+		b.value = values.Interface()
+	}
+	if !b.multiple && values.Len() > 0 {
+		b.value = values.Index(0).Interface()
+	}
+	if b.value == nil {
+		if b.multiple {
+			b.value = reflect.MakeSlice(values.Type(), 0, 0).Interface()
+		}
+		if !b.multiple {
+			b.value = reflect.Zero(values.Type().Elem()).Interface()
+		}
+	}
+}
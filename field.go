@@ -13,4 +13,7 @@ type Field[T any] struct {
 	Disabled  bool
 	Required  bool
 	Multiple  bool
+	Present   bool
+	Options   []Option
+	locale    string
 }
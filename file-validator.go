@@ -0,0 +1,105 @@
+package form
+
+import (
+	"mime"
+	"strings"
+)
+
+func (v Validators) MaxSize(size int64) Validator {
+	return validator{
+		validatorType: validatorTypeMaxSize,
+		value:         size,
+	}
+}
+
+func (v Validators) MinSize(size int64) Validator {
+	return validator{
+		validatorType: validatorTypeMinSize,
+		value:         size,
+	}
+}
+
+func (v Validators) MimeTypes(types ...string) Validator {
+	return validator{
+		validatorType: validatorTypeMimeTypes,
+		value:         types,
+	}
+}
+
+func (v Validators) Extensions(extensions ...string) Validator {
+	return validator{
+		validatorType: validatorTypeExtensions,
+		value:         extensions,
+	}
+}
+
+func validateFiles(fb *FieldBuilder, check func(file Multipart) bool) []string {
+	errors := make([]string, 0)
+	files := make([]Multipart, 0)
+	switch fv := fb.value.(type) {
+	case Multipart:
+		files = append(files, fv)
+	case []Multipart:
+		files = fv
+	}
+	for _, file := range files {
+		if file.isEmpty() {
+			continue
+		}
+		if !check(file) {
+			errors = append(errors, fb.messages.Multipart)
+			break
+		}
+	}
+	return errors
+}
+
+func validateMaxSize(fb *FieldBuilder, v validator) []string {
+	return validateFiles(
+		fb, func(file Multipart) bool {
+			return file.size() <= v.value.(int64)
+		},
+	)
+}
+
+func validateMinSize(fb *FieldBuilder, v validator) []string {
+	return validateFiles(
+		fb, func(file Multipart) bool {
+			return file.size() >= v.value.(int64)
+		},
+	)
+}
+
+func validateMimeTypes(fb *FieldBuilder, v validator) []string {
+	return validateFiles(
+		fb, func(file Multipart) bool {
+			fileType, _, err := mime.ParseMediaType(file.Type)
+			if err != nil {
+				return false
+			}
+			for _, allowed := range v.value.([]string) {
+				allowed = strings.ToLower(allowed)
+				if allowed == fileType {
+					return true
+				}
+				if prefix, ok := strings.CutSuffix(allowed, "/*"); ok && strings.HasPrefix(fileType, prefix+"/") {
+					return true
+				}
+			}
+			return false
+		},
+	)
+}
+
+func validateExtensions(fb *FieldBuilder, v validator) []string {
+	return validateFiles(
+		fb, func(file Multipart) bool {
+			for _, extension := range v.value.([]string) {
+				if strings.EqualFold(strings.TrimPrefix(extension, "."), file.Suffix) {
+					return true
+				}
+			}
+			return false
+		},
+	)
+}
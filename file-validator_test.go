@@ -0,0 +1,63 @@
+package form
+
+import (
+	"testing"
+	
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFileValidator(t *testing.T) {
+	png := Multipart{Name: "image.png", Type: "image/png", Suffix: "png", Data: make([]byte, 100)}
+	text := Multipart{Name: "test.txt", Type: "text/plain; charset=utf-8", Suffix: "txt", Size: 2000}
+	validate := func(value any, validators ...Validator) []string {
+		fb := Add("test").With(File(), validators...)
+		fb.value = value
+		fb.messages = defaultMessages
+		return validateField(fb, nil)
+	}
+	t.Run(
+		"size", func(t *testing.T) {
+			assert.Equal(t, 0, len(validate(png, Validate.MaxSize(100), Validate.MinSize(100))))
+			assert.Equal(t, []string{defaultMultipartMessage}, validate(text, Validate.MaxSize(1000)))
+			assert.Equal(t, []string{defaultMultipartMessage}, validate(png, Validate.MinSize(101)))
+			assert.Equal(t, 0, len(validate(Multipart{}, Validate.MinSize(101))))
+		},
+	)
+	t.Run(
+		"mime types", func(t *testing.T) {
+			assert.Equal(t, 0, len(validate(png, Validate.MimeTypes("image/png", "application/pdf"))))
+			assert.Equal(t, 0, len(validate(png, Validate.MimeTypes("image/*"))))
+			assert.Equal(t, 0, len(validate(text, Validate.MimeTypes("text/plain"))))
+			assert.Equal(t, []string{defaultMultipartMessage}, validate(text, Validate.MimeTypes("image/*")))
+		},
+	)
+	t.Run(
+		"extensions", func(t *testing.T) {
+			assert.Equal(t, 0, len(validate(png, Validate.Extensions(".PNG", "jpg"))))
+			assert.Equal(t, []string{defaultMultipartMessage}, validate(text, Validate.Extensions("png")))
+		},
+	)
+	t.Run(
+		"count", func(t *testing.T) {
+			files := []Multipart{png, text}
+			assert.Equal(t, 0, len(validate(files, Validate.Min(1), Validate.Max(2))))
+			assert.Equal(t, []string{defaultMultipartMessage}, validate(files, Validate.Max(1)))
+			assert.Equal(t, []string{defaultMultipartMessage}, validate(files, Validate.Min(3)))
+			assert.Equal(t, []string{defaultMultipartMessage}, validate(files, Validate.Extensions("png")))
+		},
+	)
+	t.Run(
+		"build", func(t *testing.T) {
+			_, req, err := testCreateMultipartRequest()
+			assert.Nil(t, err)
+			form, err := Build[testForm](
+				New(
+					Add("test").With(File(), Validate.MimeTypes("text/plain"), Validate.MaxSize(512)),
+				).Request(req),
+			)
+			assert.Nil(t, err)
+			assert.False(t, form.Valid)
+			assert.Equal(t, []string{defaultMultipartMessage}, form.Test.Messages)
+		},
+	)
+}
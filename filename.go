@@ -0,0 +1,137 @@
+package form
+
+import (
+	"mime"
+	"strings"
+	"unicode"
+	"unicode/utf8"
+	
+	"golang.org/x/text/unicode/norm"
+)
+
+const (
+	maxFileNameLength  = 255
+	defaultFileName    = "file"
+	invalidFileNameSet = `<>:"/\|?*`
+)
+
+var (
+	fileTypeSuffixes = map[string]string{
+		"image/png":                    "png",
+		"image/jpeg":                   "jpg",
+		"image/gif":                    "gif",
+		"image/webp":                   "webp",
+		"image/bmp":                    "bmp",
+		"image/x-icon":                 "ico",
+		"application/pdf":              "pdf",
+		"application/x-gzip":           "gz",
+		"application/x-rar-compressed": "rar",
+		"application/wasm":             "wasm",
+		"application/ogg":              "ogg",
+		"audio/mpeg":                   "mp3",
+		"audio/wave":                   "wav",
+		"audio/aiff":                   "aiff",
+		"audio/midi":                   "mid",
+		"video/mp4":                    "mp4",
+		"video/webm":                   "webm",
+		"video/avi":                    "avi",
+		"font/ttf":                     "ttf",
+		"font/otf":                     "otf",
+		"font/woff":                    "woff",
+		"font/woff2":                   "woff2",
+	}
+	fileSuffixTypes = map[string]string{
+		"png":   "image/png",
+		"jpg":   "image/jpeg",
+		"jpeg":  "image/jpeg",
+		"jpe":   "image/jpeg",
+		"jfif":  "image/jpeg",
+		"gif":   "image/gif",
+		"webp":  "image/webp",
+		"bmp":   "image/bmp",
+		"ico":   "image/x-icon",
+		"pdf":   "application/pdf",
+		"gz":    "application/x-gzip",
+		"tgz":   "application/x-gzip",
+		"rar":   "application/x-rar-compressed",
+		"wasm":  "application/wasm",
+		"ogg":   "application/ogg",
+		"oga":   "application/ogg",
+		"ogv":   "application/ogg",
+		"mp3":   "audio/mpeg",
+		"wav":   "audio/wave",
+		"aif":   "audio/aiff",
+		"aiff":  "audio/aiff",
+		"mid":   "audio/midi",
+		"midi":  "audio/midi",
+		"mp4":   "video/mp4",
+		"m4v":   "video/mp4",
+		"m4a":   "video/mp4",
+		"webm":  "video/webm",
+		"avi":   "video/avi",
+		"ttf":   "font/ttf",
+		"otf":   "font/otf",
+		"woff":  "font/woff",
+		"woff2": "font/woff2",
+	}
+	reservedFileNames = map[string]bool{
+		"con": true, "prn": true, "aux": true, "nul": true,
+		"com1": true, "com2": true, "com3": true, "com4": true, "com5": true, "com6": true, "com7": true, "com8": true,
+		"com9": true,
+		"lpt1": true, "lpt2": true, "lpt3": true, "lpt4": true, "lpt5": true, "lpt6": true, "lpt7": true, "lpt8": true,
+		"lpt9": true,
+	}
+)
+
+func sanitizeFileName(name string) string {
+	name = strings.ReplaceAll(name, "\\", "/")
+	name = name[strings.LastIndex(name, "/")+1:]
+	name = norm.NFC.String(strings.ToValidUTF8(name, ""))
+	name = strings.Map(
+		func(r rune) rune {
+			if unicode.IsControl(r) || strings.ContainsRune(invalidFileNameSet, r) {
+				return -1
+			}
+			return r
+		},
+		name,
+	)
+	name = strings.Trim(name, ". ")
+	if len(name) == 0 {
+		return defaultFileName
+	}
+	base, _, _ := strings.Cut(name, ".")
+	if reservedFileNames[strings.ToLower(strings.TrimSpace(base))] {
+		name = "_" + name
+	}
+	return truncateFileName(name, maxFileNameLength)
+}
+
+func truncateFileName(name string, length int) string {
+	if len(name) <= length {
+		return name
+	}
+	extension := ""
+	if index := strings.LastIndex(name, "."); index > 0 && len(name)-index < length/2 {
+		extension = name[index:]
+	}
+	base := name[:len(name)-len(extension)]
+	limit := length - len(extension)
+	for limit > 0 && !utf8.RuneStart(base[limit]) {
+		limit--
+	}
+	return strings.TrimRight(base[:limit], ". ") + extension
+}
+
+func getFileSuffix(name, fileType string) string {
+	suffix := getFileSuffixFromName(name)
+	mediaType, _, err := mime.ParseMediaType(fileType)
+	if err != nil {
+		return suffix
+	}
+	typeSuffix, ok := fileTypeSuffixes[mediaType]
+	if !ok || fileSuffixTypes[suffix] == mediaType {
+		return suffix
+	}
+	return typeSuffix
+}
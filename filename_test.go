@@ -0,0 +1,43 @@
+package form
+
+import (
+	"strings"
+	"testing"
+	
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFileName(t *testing.T) {
+	t.Run(
+		"sanitize file name", func(t *testing.T) {
+			assert.Equal(t, "passwd", sanitizeFileName("../../etc/passwd"))
+			assert.Equal(t, "photo.jpg", sanitizeFileName(`C:\Users\test\photo.jpg`))
+			assert.Equal(t, "report.pdf", sanitizeFileName("re\x00po<r>t.pdf"))
+			assert.Equal(t, "\u017elu\u0165ou\u010dk\u00fd.txt", sanitizeFileName("z\u030clut\u030couc\u030cky\u0301.txt"))
+			assert.Equal(t, "bashrc", sanitizeFileName(".bashrc"))
+			assert.Equal(t, "_CON.txt", sanitizeFileName("CON.txt"))
+			assert.Equal(t, defaultFileName, sanitizeFileName(".."))
+			assert.Equal(t, defaultFileName, sanitizeFileName("/"))
+		},
+	)
+	t.Run(
+		"truncate file name", func(t *testing.T) {
+			name := sanitizeFileName(strings.Repeat("\u017e", 200) + ".png")
+			assert.LessOrEqual(t, len(name), maxFileNameLength)
+			assert.True(t, strings.HasSuffix(name, "\u017e.png"))
+		},
+	)
+	t.Run(
+		"get file suffix", func(t *testing.T) {
+			assert.Equal(t, "png", getFileSuffix("image.png", "image/png"))
+			assert.Equal(t, "jpeg", getFileSuffix("image.jpeg", "image/jpeg"))
+			assert.Equal(t, "png", getFileSuffix("image.jpg", "image/png"))
+			assert.Equal(t, "png", getFileSuffix("image", "image/png"))
+			assert.Equal(t, "pdf", getFileSuffix("invoice.exe", "application/pdf"))
+			assert.Equal(t, "gz", getFileSuffix("archive.tar.gz", "application/x-gzip"))
+			assert.Equal(t, "csv", getFileSuffix("data.csv", "text/plain; charset=utf-8"))
+			assert.Equal(t, "docx", getFileSuffix("letter.docx", "application/zip"))
+			assert.Equal(t, "", getFileSuffix("data", "application/octet-stream"))
+		},
+	)
+}
@@ -12,10 +12,17 @@ type Builder struct {
 	name        string
 	contentType string
 	limit       int
+	stream      bool
+	threshold   int64
 	submitted   bool
+	built       bool
 	hx          bool
+	locale      string
 	security    security
 	messages    Messages
+	errors      []string
+	renderer    Renderer
+	layout      formLayout
 }
 
 const (
@@ -75,6 +82,48 @@ func (b *Builder) Messages(messages Messages) *Builder {
 	if len(messages.Email) > 0 {
 		b.messages.Email = messages.Email
 	}
+	if len(messages.MinItems) > 0 {
+		b.messages.MinItems = messages.MinItems
+	}
+	if len(messages.MaxItems) > 0 {
+		b.messages.MaxItems = messages.MaxItems
+	}
+	if len(messages.Positive) > 0 {
+		b.messages.Positive = messages.Positive
+	}
+	if len(messages.Decimals) > 0 {
+		b.messages.Decimals = messages.Decimals
+	}
+	if len(messages.Currency) > 0 {
+		b.messages.Currency = messages.Currency
+	}
+	if len(messages.Url) > 0 {
+		b.messages.Url = messages.Url
+	}
+	if len(messages.Uuid) > 0 {
+		b.messages.Uuid = messages.Uuid
+	}
+	if len(messages.Phone) > 0 {
+		b.messages.Phone = messages.Phone
+	}
+	if len(messages.Iban) > 0 {
+		b.messages.Iban = messages.Iban
+	}
+	if len(messages.Ico) > 0 {
+		b.messages.Ico = messages.Ico
+	}
+	if len(messages.Dic) > 0 {
+		b.messages.Dic = messages.Dic
+	}
+	if len(messages.CreditCard) > 0 {
+		b.messages.CreditCard = messages.CreditCard
+	}
+	if len(messages.HexColor) > 0 {
+		b.messages.HexColor = messages.HexColor
+	}
+	if len(messages.PostalCode) > 0 {
+		b.messages.PostalCode = messages.PostalCode
+	}
 	return b
 }
 
@@ -92,6 +141,11 @@ func (b *Builder) Limit(limit int) *Builder {
 	b.limit = limit
 	return b
 }
+func (b *Builder) Locale(locale string) *Builder {
+	b.locale = locale
+	return b
+}
+
 func (b *Builder) Method(method string) *Builder {
 	b.method = method
 	return b
@@ -104,6 +158,21 @@ func (b *Builder) Name(name string) *Builder {
 
 func (b *Builder) Request(request *http.Request) *Builder {
 	b.request = request
+	b.built = false
+	return b
+}
+
+func (b *Builder) Stream(threshold ...int64) *Builder {
+	b.stream = true
+	b.threshold = defaultStreamThreshold
+	if len(threshold) > 0 {
+		b.threshold = threshold[0]
+	}
+	return b
+}
+
+func (b *Builder) Theme(renderer Renderer) *Builder {
+	b.renderer = renderer
 	return b
 }
 
@@ -113,6 +182,9 @@ func (b *Builder) Hx() *Builder {
 }
 
 func (b *Builder) isValid() bool {
+	if len(b.errors) > 0 {
+		return false
+	}
 	if !b.submitted {
 		return true
 	}
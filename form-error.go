@@ -0,0 +1,133 @@
+package form
+
+import (
+	"slices"
+	
+	"github.com/creamsensation/gox"
+)
+
+type FieldError struct {
+	Id      string
+	Name    string
+	Label   string
+	Message string
+}
+
+func (b *Builder) AddError(message string) *Builder {
+	b.errors = append(b.errors, message)
+	return b
+}
+
+func (f *Form) AddError(message string) {
+	f.getState().errors = append(f.getState().errors, message)
+	f.Valid = false
+}
+
+func (f *Form) AddFieldError(name, message string) {
+	fieldError := FieldError{Name: name, Message: message}
+	if field := findField(f.getFields(), name); field != nil {
+		fieldError.Id = field.id
+		fieldError.Label = field.label
+	}
+	f.getState().fieldErrors = append(f.getState().fieldErrors, fieldError)
+	f.Valid = false
+}
+
+func AddFieldError[T any](form *Form, field *Field[T], message string) {
+	form.AddFieldError(field.Name, message)
+	field.Messages = append(slices.Clip(field.Messages), message)
+}
+
+func (f Form) Errors() []string {
+	if f.state == nil {
+		return nil
+	}
+	return f.state.errors
+}
+
+func (f Form) FieldErrors() []FieldError {
+	if f.state == nil {
+		return nil
+	}
+	return f.state.fieldErrors
+}
+
+func (f Form) FieldMessages(name string) []string {
+	result := make([]string, 0)
+	for _, fieldError := range f.FieldErrors() {
+		if fieldError.Name == name {
+			result = append(result, fieldError.Message)
+		}
+	}
+	return result
+}
+
+func (f Form) ErrorSummary(nodes ...gox.Node) gox.Node {
+	if len(f.Errors()) == 0 && len(f.FieldErrors()) == 0 {
+		return gox.Fragment()
+	}
+	items := make([]gox.Node, 0, len(f.Errors())+len(f.FieldErrors()))
+	for _, message := range f.Errors() {
+		items = append(items, gox.Li(gox.Text(message)))
+	}
+	for _, fieldError := range f.FieldErrors() {
+		items = append(
+			items, gox.Li(
+				gox.A(
+					gox.Href("#"+getFieldId(fieldError.Id, fieldError.Name)),
+					gox.Text(fieldError.text()),
+				),
+			),
+		)
+	}
+	return gox.Div(
+		gox.Attribute("role", "alert"),
+		gox.Fragment(nodes...),
+		gox.Ul(items...),
+	)
+}
+
+func (e FieldError) text() string {
+	if len(e.Label) > 0 {
+		return e.Label + ": " + e.Message
+	}
+	return e.Message
+}
+
+func collectFieldErrors(fields []*FieldBuilder, result []FieldError) []FieldError {
+	for _, field := range fields {
+		for _, message := range field.errors {
+			result = append(
+				result, FieldError{
+					Id:      field.id,
+					Name:    field.fullName(),
+					Label:   field.label,
+					Message: message,
+				},
+			)
+		}
+		if field.group != nil {
+			for _, item := range field.group.items {
+				result = collectFieldErrors(item.fields, result)
+			}
+		}
+	}
+	return result
+}
+
+func findField(fields []*FieldBuilder, name string) *FieldBuilder {
+	for _, field := range fields {
+		if field.fullName() == name {
+			return field
+		}
+		if field.group == nil {
+			continue
+		}
+		for _, item := range field.group.items {
+			if result := findField(item.fields, name); result != nil {
+				return result
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,97 @@
+package form
+
+import (
+	"net/url"
+	"testing"
+	
+	"github.com/creamsensation/gox"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormError(t *testing.T) {
+	t.Run(
+		"field errors", func(t *testing.T) {
+			form, err := Build[testForm](testCreateErrorBuilder(url.Values{"name": {"Test"}}))
+			assert.Nil(t, err)
+			assert.False(t, form.Valid)
+			assert.Equal(
+				t,
+				[]FieldError{{Id: "email-input", Name: "email", Label: "Email", Message: defaultRequiredMessage}},
+				form.FieldErrors(),
+			)
+			invoice, err := Build[testInvoiceForm](testCreateErrorBuilder(url.Values{"lines[0].qty": {"0"}}))
+			assert.Nil(t, err)
+			assert.Equal(t, []FieldError{{Name: "lines[0].qty", Message: defaultMinNumberMessage}}, invoice.FieldErrors())
+			assert.Equal(t, []string{defaultMinNumberMessage}, invoice.FieldMessages("lines[0].qty"))
+		},
+	)
+	t.Run(
+		"builder error", func(t *testing.T) {
+			values := url.Values{"email": {"test@test.cz"}, "name": {"Test"}}
+			form, err := Build[testForm](testCreateErrorBuilder(values).AddError("Invalid credentials"))
+			assert.Nil(t, err)
+			assert.False(t, form.Valid)
+			assert.Equal(t, []string{"Invalid credentials"}, form.Errors())
+			assert.Equal(t, 0, len(form.FieldErrors()))
+		},
+	)
+	t.Run(
+		"add after build", func(t *testing.T) {
+			values := url.Values{"email": {"test@test.cz"}, "name": {"Test"}}
+			form, err := Build[testForm](testCreateErrorBuilder(values))
+			assert.Nil(t, err)
+			assert.True(t, form.Valid)
+			form.AddFieldError("email", "Email is already registered")
+			assert.False(t, form.Valid)
+			assert.Equal(
+				t,
+				FieldError{Id: "email-input", Name: "email", Label: "Email", Message: "Email is already registered"},
+				form.FieldErrors()[0],
+			)
+			form.AddError("This offer expired")
+			assert.Equal(t, []string{"This offer expired"}, form.Errors())
+			AddFieldError(&form.Form, &form.Name, "Name is taken")
+			assert.Equal(t, []string{"Name is taken"}, form.Name.Messages)
+			assert.Equal(t, []string{"Name is taken"}, form.FieldMessages("name"))
+			field := gox.Render(FieldNode(form.Form, form.Email))
+			assert.Contains(t, field, `aria-invalid="true"`)
+			assert.Contains(t, field, `aria-describedby="email-input-error"`)
+			assert.Contains(t, field, `<div id="email-input-error"><p>Email is already registered</p></div>`)
+			assert.Contains(t, gox.Render(ControlNode(form.Form, form.Email)), `aria-invalid="true"`)
+			assert.Contains(t, gox.Render(form.ErrorSummary()), `<a href="#email-input">Email: Email is already registered</a>`)
+		},
+	)
+	t.Run(
+		"forms do not share errors", func(t *testing.T) {
+			values := url.Values{"email": {"test@test.cz"}, "name": {"Test"}}
+			b := testCreateErrorBuilder(values).AddError("First").AddError("Second").AddError("Third")
+			first, err := Build[testForm](b)
+			assert.Nil(t, err)
+			second, err := Build[testForm](b)
+			assert.Nil(t, err)
+			first.AddError("First form")
+			second.AddError("Second form")
+			assert.Equal(t, []string{"First", "Second", "Third", "First form"}, first.Errors())
+			assert.Equal(t, []string{"First", "Second", "Third", "Second form"}, second.Errors())
+			assert.Equal(t, []string{"First", "Second", "Third"}, b.errors)
+			first.AddFieldError("email", "Email is already registered")
+			assert.Equal(t, 0, len(second.FieldErrors()))
+			assert.NotContains(t, gox.Render(FieldNode(second.Form, second.Email)), "Email is already registered")
+			assert.Empty(t, findField(b.fields, "email").errors)
+			assert.False(t, first.Form == second.Form)
+		},
+	)
+	t.Run(
+		"error summary", func(t *testing.T) {
+			assert.Equal(t, "", gox.Render(Form{}.ErrorSummary()))
+			form := Form{}
+			form.AddError("Invalid credentials")
+			form.AddFieldError("lines[0].qty", "Too small")
+			summary := gox.Render(form.ErrorSummary(gox.Class("errors")))
+			assert.Contains(t, summary, `role="alert"`)
+			assert.Contains(t, summary, `class="errors"`)
+			assert.Contains(t, summary, `<li>Invalid credentials</li>`)
+			assert.Contains(t, summary, `<a href="#lines-0-qty">Too small</a>`)
+		},
+	)
+}
@@ -10,6 +10,16 @@ type Form struct {
 	Valid       bool
 	Submitted   bool
 	Hx          bool
+	Locale      string
+	state       *formState
+}
+
+type formState struct {
+	errors      []string
+	fieldErrors []FieldError
+	fields      []*FieldBuilder
+	renderer    Renderer
+	layout      formLayout
 }
 
 func (f Form) Csrf() gox.Node {
@@ -25,3 +35,24 @@ func (f Form) Node(nodes ...gox.Node) gox.Node {
 		gox.Fragment(nodes...),
 	)
 }
+
+func (f *Form) getState() *formState {
+	if f.state == nil {
+		f.state = new(formState)
+	}
+	return f.state
+}
+
+func (f Form) getFields() []*FieldBuilder {
+	if f.state == nil {
+		return nil
+	}
+	return f.state.fields
+}
+
+func (f Form) getLayout() formLayout {
+	if f.state == nil {
+		return formLayout{}
+	}
+	return f.state.layout
+}
@@ -0,0 +1,241 @@
+package form
+
+import (
+	"fmt"
+	"math/big"
+	"net/url"
+	"regexp"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+)
+
+var (
+	defaultUrlSchemes = []string{"http", "https"}
+	uuidRegexp        = regexp.MustCompile(`^[0-9a-fA-F]{8}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{4}-[0-9a-fA-F]{12}$`)
+	e164PhoneRegexp   = regexp.MustCompile(`^\+[1-9][0-9]{1,14}$`)
+	hexColorRegexp    = regexp.MustCompile(`^#(?:[0-9a-fA-F]{3}|[0-9a-fA-F]{6})$`)
+	ibanRegexp        = regexp.MustCompile(`^[A-Z]{2}[0-9]{2}[A-Z0-9]{11,30}$`)
+	czechIcoRegexp    = regexp.MustCompile(`^[0-9]{8}$`)
+	czechDicRegexp    = regexp.MustCompile(`^CZ[0-9]{8,10}$`)
+	ibanLengths       = map[string]int{
+		"AT": 20, "BE": 16, "BG": 22, "CH": 21, "CY": 28, "CZ": 24, "DE": 22, "DK": 18, "EE": 20, "ES": 24,
+		"FI": 18, "FR": 27, "GB": 22, "GR": 27, "HR": 21, "HU": 28, "IE": 22, "IS": 26, "IT": 27, "LI": 21,
+		"LT": 20, "LU": 20, "LV": 21, "MT": 31, "NL": 18, "NO": 15, "PL": 28, "PT": 25, "RO": 24, "SE": 24,
+		"SI": 19, "SK": 24,
+	}
+	postalCodeRegexps = map[string]*regexp.Regexp{
+		"AT": regexp.MustCompile(`^[0-9]{4}$`),
+		"CA": regexp.MustCompile(`^[A-Za-z][0-9][A-Za-z] ?[0-9][A-Za-z][0-9]$`),
+		"CH": regexp.MustCompile(`^[0-9]{4}$`),
+		"CZ": regexp.MustCompile(`^[0-9]{3} ?[0-9]{2}$`),
+		"DE": regexp.MustCompile(`^[0-9]{5}$`),
+		"FR": regexp.MustCompile(`^[0-9]{5}$`),
+		"GB": regexp.MustCompile(`^[A-Za-z]{1,2}[0-9][A-Za-z0-9]? ?[0-9][A-Za-z]{2}$`),
+		"HU": regexp.MustCompile(`^[0-9]{4}$`),
+		"NL": regexp.MustCompile(`^[0-9]{4} ?[A-Za-z]{2}$`),
+		"PL": regexp.MustCompile(`^[0-9]{2}-[0-9]{3}$`),
+		"SK": regexp.MustCompile(`^[0-9]{3} ?[0-9]{2}$`),
+		"US": regexp.MustCompile(`^[0-9]{5}(?:-[0-9]{4})?$`),
+	}
+)
+
+func (v Validators) Url(schemes ...string) Validator {
+	if len(schemes) == 0 {
+		schemes = defaultUrlSchemes
+	}
+	return validator{
+		validatorType: validatorTypeUrl,
+		value: convertSlice[string, string](
+			schemes, func(scheme string) string {
+				return strings.ToLower(scheme)
+			},
+		),
+	}
+}
+
+func (v Validators) Uuid() Validator {
+	return validator{
+		validatorType: validatorTypeUuid,
+	}
+}
+
+func (v Validators) E164Phone() Validator {
+	return validator{
+		validatorType: validatorTypePhone,
+	}
+}
+
+func (v Validators) Iban() Validator {
+	return validator{
+		validatorType: validatorTypeIban,
+	}
+}
+
+func (v Validators) CzechIco() Validator {
+	return validator{
+		validatorType: validatorTypeCzechIco,
+	}
+}
+
+func (v Validators) CzechDic() Validator {
+	return validator{
+		validatorType: validatorTypeCzechDic,
+	}
+}
+
+func (v Validators) CreditCard() Validator {
+	return validator{
+		validatorType: validatorTypeCreditCard,
+	}
+}
+
+func (v Validators) HexColor() Validator {
+	return validator{
+		validatorType: validatorTypeHexColor,
+	}
+}
+
+func (v Validators) PostalCode(country string) Validator {
+	country = strings.ToUpper(country)
+	if _, ok := postalCodeRegexps[country]; !ok {
+		panic(fmt.Errorf("error while creating postal code validator: unsupported country %s", country))
+	}
+	return validator{
+		validatorType: validatorTypePostalCode,
+		value:         country,
+	}
+}
+
+func validateFormat(fb *FieldBuilder, message string, valid func(string) bool) []string {
+	errors := make([]string, 0)
+	switch fv := fb.value.(type) {
+	case []string:
+		for _, item := range fv {
+			if len(item) > 0 && !valid(item) {
+				errors = append(errors, message)
+				break
+			}
+		}
+	case string:
+		if len(fv) > 0 && !valid(fv) {
+			errors = append(errors, message)
+		}
+	}
+	return errors
+}
+
+func isUrlValid(value string, schemes []string) bool {
+	u, err := url.Parse(value)
+	if err != nil || !slices.Contains(schemes, strings.ToLower(u.Scheme)) {
+		return false
+	}
+	if strings.EqualFold(u.Scheme, "http") || strings.EqualFold(u.Scheme, "https") {
+		return len(u.Host) > 0
+	}
+	return len(u.Host) > 0 || len(u.Opaque) > 0
+}
+
+func isIbanValid(value string) bool {
+	iban := strings.ToUpper(strings.ReplaceAll(value, " ", ""))
+	if !ibanRegexp.MatchString(iban) {
+		return false
+	}
+	if length, ok := ibanLengths[iban[:2]]; ok && len(iban) != length {
+		return false
+	}
+	digits := new(strings.Builder)
+	for _, r := range iban[4:] + iban[:4] {
+		if r >= 'A' && r <= 'Z' {
+			digits.WriteString(strconv.Itoa(int(r-'A') + 10))
+			continue
+		}
+		digits.WriteRune(r)
+	}
+	number, ok := new(big.Int).SetString(digits.String(), 10)
+	if !ok {
+		return false
+	}
+	return new(big.Int).Mod(number, big.NewInt(97)).Int64() == 1
+}
+
+func isCzechIcoValid(value string) bool {
+	if !czechIcoRegexp.MatchString(value) {
+		return false
+	}
+	sum := 0
+	for i := 0; i < 7; i++ {
+		sum += int(value[i]-'0') * (8 - i)
+	}
+	return int(value[7]-'0') == (11-sum%11)%10
+}
+
+func isCzechDicValid(value string) bool {
+	dic := strings.ToUpper(strings.ReplaceAll(value, " ", ""))
+	if !czechDicRegexp.MatchString(dic) {
+		return false
+	}
+	number := dic[2:]
+	switch len(number) {
+	case 8:
+		return isCzechIcoValid(number)
+	case 9:
+		return isCzechBirthDateValid(number)
+	case 10:
+		n, err := strconv.ParseInt(number, 10, 64)
+		if err != nil || !isCzechBirthDateValid(number) {
+			return false
+		}
+		base := n / 10
+		return n%11 == 0 || base%11 == 10 && n%10 == 0
+	}
+	return false
+}
+
+func isCzechBirthDateValid(number string) bool {
+	year, _ := strconv.Atoi(number[0:2])
+	month, _ := strconv.Atoi(number[2:4])
+	day, _ := strconv.Atoi(number[4:6])
+	switch {
+	case month > 70 && len(number) == 10:
+		month -= 70
+	case month > 50:
+		month -= 50
+	case month > 20 && len(number) == 10:
+		month -= 20
+	}
+	year += 1900
+	if len(number) == 9 && year >= 1954 {
+		return false
+	}
+	if len(number) == 10 && year < 1954 {
+		year += 100
+	}
+	date := time.Date(year, time.Month(month), day, 0, 0, 0, 0, time.UTC)
+	return month >= 1 && month <= 12 && date.Month() == time.Month(month) && date.Day() == day
+}
+
+func isCreditCardValid(value string) bool {
+	number := strings.NewReplacer(" ", "", "-", "").Replace(value)
+	if len(number) < 12 || len(number) > 19 || !isDigits(number) {
+		return false
+	}
+	sum := 0
+	for i := len(number) - 1; i >= 0; i-- {
+		digit := int(number[i] - '0')
+		if (len(number)-i)%2 == 0 {
+			digit *= 2
+			if digit > 9 {
+				digit -= 9
+			}
+		}
+		sum += digit
+	}
+	return sum%10 == 0
+}
+
+func isPostalCodeValid(value, country string) bool {
+	pattern, ok := postalCodeRegexps[country]
+	return ok && pattern.MatchString(strings.TrimSpace(value))
+}
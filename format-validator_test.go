@@ -0,0 +1,94 @@
+package form
+
+import (
+	"testing"
+	
+	"github.com/stretchr/testify/assert"
+)
+
+func TestFormatValidator(t *testing.T) {
+	validate := func(value string, v Validator) []string {
+		form, err := Build[testForm](New(Add("name").With(Text(value), v)))
+		assert.Nil(t, err)
+		return form.Name.Messages
+	}
+	for _, item := range []struct {
+		name      string
+		validator Validator
+		message   string
+		valid     []string
+		invalid   []string
+	}{
+		{
+			"url", Validate.Url(), defaultUrlMessage,
+			[]string{"https://example.com/path?q=1", "HTTP://example.com"},
+			[]string{"javascript:alert(1)", "ftp://example.com", "example.com", "https://", "http:foo", "https:example.com"},
+		},
+		{
+			"url schemes", Validate.Url("mailto", "FTP"), defaultUrlMessage,
+			[]string{"mailto:user@example.com", "ftp://example.com/file"},
+			[]string{"https://example.com"},
+		},
+		{
+			"uuid", Validate.Uuid(), defaultUuidMessage,
+			[]string{"123e4567-e89b-12d3-a456-426614174000", "123E4567-E89B-12D3-A456-426614174000"},
+			[]string{"123e4567e89b12d3a456426614174000", "123e4567-e89b-12d3-a456-42661417400g"},
+		},
+		{
+			"phone", Validate.E164Phone(), defaultPhoneMessage,
+			[]string{"+420123456789", "+14155552671"},
+			[]string{"420123456789", "+0123456", "+420 123 456 789", "+1234567890123456"},
+		},
+		{
+			"iban", Validate.Iban(), defaultIbanMessage,
+			[]string{"CZ6508000000192000145399", "GB82 WEST 1234 5698 7654 32", "de89370400440532013000"},
+			[]string{"CZ6508000000192000145398", "CZ650800000019200014539", "XX00"},
+		},
+		{
+			"czech ico", Validate.CzechIco(), defaultIcoMessage,
+			[]string{"25596641", "00006947"},
+			[]string{"12345678", "2559664", "2559664a"},
+		},
+		{
+			"czech dic", Validate.CzechDic(), defaultDicMessage,
+			[]string{"CZ25596641", "cz7304071203", "CZ530101123"},
+			[]string{"CZ12345678", "CZ7304071234", "SK25596641", "25596641", "CZ123456789", "CZ650101123", "CZ7313071205"},
+		},
+		{
+			"credit card", Validate.CreditCard(), defaultCreditCardMessage,
+			[]string{"4111111111111111", "4111 1111 1111 1111", "5500-0000-0000-0004"},
+			[]string{"4111111111111112", "1234", "4111a11111111111"},
+		},
+		{
+			"hex color", Validate.HexColor(), defaultHexColorMessage,
+			[]string{"#ff0000", "#ABC"},
+			[]string{"ff0000", "#ff00", "#gg0000"},
+		},
+		{
+			"postal code", Validate.PostalCode("cz"), defaultPostalCodeMessage,
+			[]string{"110 00", "11000"},
+			[]string{"1100", "110-00"},
+		},
+	} {
+		t.Run(
+			item.name, func(t *testing.T) {
+				for _, value := range item.valid {
+					assert.Empty(t, validate(value, item.validator), value)
+				}
+				for _, value := range item.invalid {
+					assert.Equal(t, []string{item.message}, validate(value, item.validator), value)
+				}
+				assert.Empty(t, validate("", item.validator))
+			},
+		)
+	}
+	t.Run(
+		"postal code unknown country", func(t *testing.T) {
+			assert.Panics(
+				t, func() {
+					Validate.PostalCode("XX")
+				},
+			)
+		},
+	)
+}
@@ -0,0 +1,363 @@
+// Code generated by formgen. DO NOT EDIT.
+
+package form
+
+func BuildTestForm(b *Builder) (testForm, error) {
+	return Bind(b, bindTestForm)
+}
+
+func bindTestForm(binder *Binder) (testForm, error) {
+	var result testForm
+	for binder.Next() {
+		var err error
+		switch binder.Name() {
+		case "Roles":
+			result.Roles, err = BindField[[]string](binder)
+		case "Email":
+			result.Email, err = BindField[string](binder)
+		case "Name":
+			result.Name, err = BindField[string](binder)
+		case "Quantity":
+			result.Quantity, err = BindField[int](binder)
+		case "Amount":
+			result.Amount, err = BindField[float64](binder)
+		case "Checked":
+			result.Checked, err = BindField[bool](binder)
+		case "Test":
+			result.Test, err = BindField[Multipart](binder)
+		}
+		if err != nil {
+			return result, err
+		}
+	}
+	result.Form = binder.Form()
+	return result, nil
+}
+
+func BuildTestInvoiceForm(b *Builder) (testInvoiceForm, error) {
+	return Bind(b, bindTestInvoiceForm)
+}
+
+func bindTestInvoiceForm(binder *Binder) (testInvoiceForm, error) {
+	var result testInvoiceForm
+	for binder.Next() {
+		var err error
+		switch binder.Name() {
+		case "Lines":
+			result.Lines, err = BindGroups(binder, bindTestLineForm)
+		case "Address":
+			result.Address, err = BindGroup(binder, bindTestAddressForm)
+		}
+		if err != nil {
+			return result, err
+		}
+	}
+	result.Form = binder.Form()
+	return result, nil
+}
+
+func bindTestLineForm(binder *Binder) (testLineForm, error) {
+	var result testLineForm
+	for binder.Next() {
+		var err error
+		switch binder.Name() {
+		case "Description":
+			result.Description, err = BindField[string](binder)
+		case "Qty":
+			result.Qty, err = BindField[int](binder)
+		}
+		if err != nil {
+			return result, err
+		}
+	}
+	return result, nil
+}
+
+func bindTestAddressForm(binder *Binder) (testAddressForm, error) {
+	var result testAddressForm
+	for binder.Next() {
+		var err error
+		switch binder.Name() {
+		case "Street":
+			result.Street, err = BindField[string](binder)
+		case "City":
+			result.City, err = BindField[string](binder)
+		}
+		if err != nil {
+			return result, err
+		}
+	}
+	return result, nil
+}
+
+func BuildTestCustomForm(b *Builder) (testCustomForm, error) {
+	return Bind(b, bindTestCustomForm)
+}
+
+func bindTestCustomForm(binder *Binder) (testCustomForm, error) {
+	var result testCustomForm
+	for binder.Next() {
+		var err error
+		switch binder.Name() {
+		case "Owner":
+			result.Owner, err = BindField[testUserId](binder)
+		case "Color":
+			result.Color, err = BindField[testColor](binder)
+		case "Slug":
+			result.Slug, err = BindField[testSlug](binder)
+		case "Tags":
+			result.Tags, err = BindField[[]testSlug](binder)
+		case "Quantity":
+			result.Quantity, err = BindField[testQuantity](binder)
+		}
+		if err != nil {
+			return result, err
+		}
+	}
+	result.Form = binder.Form()
+	return result, nil
+}
+
+func BuildTestEnumForm(b *Builder) (testEnumForm, error) {
+	return Bind(b, bindTestEnumForm)
+}
+
+func bindTestEnumForm(binder *Binder) (testEnumForm, error) {
+	var result testEnumForm
+	for binder.Next() {
+		var err error
+		switch binder.Name() {
+		case "Status":
+			result.Status, err = BindField[testStatus](binder)
+		case "Level":
+			result.Level, err = BindField[testLevel](binder)
+		case "Tags":
+			result.Tags, err = BindField[[]testStatus](binder)
+		}
+		if err != nil {
+			return result, err
+		}
+	}
+	result.Form = binder.Form()
+	return result, nil
+}
+
+func BuildTestNumberForm(b *Builder) (testNumberForm, error) {
+	return Bind(b, bindTestNumberForm)
+}
+
+func bindTestNumberForm(binder *Binder) (testNumberForm, error) {
+	var result testNumberForm
+	for binder.Next() {
+		var err error
+		switch binder.Name() {
+		case "Count":
+			result.Count, err = BindField[int64](binder)
+		case "Small":
+			result.Small, err = BindField[int8](binder)
+		case "Unsigned":
+			result.Unsigned, err = BindField[uint](binder)
+		case "Ratio":
+			result.Ratio, err = BindField[float32](binder)
+		case "Price":
+			result.Price, err = BindField[Decimal](binder)
+		case "Counts":
+			result.Counts, err = BindField[[]int64](binder)
+		}
+		if err != nil {
+			return result, err
+		}
+	}
+	result.Form = binder.Form()
+	return result, nil
+}
+
+func BuildTestMoneyForm(b *Builder) (testMoneyForm, error) {
+	return Bind(b, bindTestMoneyForm)
+}
+
+func bindTestMoneyForm(binder *Binder) (testMoneyForm, error) {
+	var result testMoneyForm
+	for binder.Next() {
+		var err error
+		switch binder.Name() {
+		case "Price":
+			result.Price, err = BindField[Amount](binder)
+		case "Discount":
+			result.Discount, err = BindField[Decimal](binder)
+		}
+		if err != nil {
+			return result, err
+		}
+	}
+	result.Form = binder.Form()
+	return result, nil
+}
+
+func BuildTestCheckboxForm(b *Builder) (testCheckboxForm, error) {
+	return Bind(b, bindTestCheckboxForm)
+}
+
+func bindTestCheckboxForm(binder *Binder) (testCheckboxForm, error) {
+	var result testCheckboxForm
+	for binder.Next() {
+		var err error
+		switch binder.Name() {
+		case "Roles":
+			result.Roles, err = BindField[[]string](binder)
+		case "Agree":
+			result.Agree, err = BindField[bool](binder)
+		case "Levels":
+			result.Levels, err = BindField[[]testLevel](binder)
+		case "Flags":
+			result.Flags, err = BindField[[]bool](binder)
+		}
+		if err != nil {
+			return result, err
+		}
+	}
+	result.Form = binder.Form()
+	return result, nil
+}
+
+func BuildTestBenchmarkForm(b *Builder) (testBenchmarkForm, error) {
+	return Bind(b, bindTestBenchmarkForm)
+}
+
+func bindTestBenchmarkForm(binder *Binder) (testBenchmarkForm, error) {
+	var result testBenchmarkForm
+	for binder.Next() {
+		var err error
+		switch binder.Name() {
+		case "Text1":
+			result.Text1, err = BindField[string](binder)
+		case "Text2":
+			result.Text2, err = BindField[string](binder)
+		case "Text3":
+			result.Text3, err = BindField[string](binder)
+		case "Text4":
+			result.Text4, err = BindField[string](binder)
+		case "Text5":
+			result.Text5, err = BindField[string](binder)
+		case "Text6":
+			result.Text6, err = BindField[string](binder)
+		case "Text7":
+			result.Text7, err = BindField[string](binder)
+		case "Text8":
+			result.Text8, err = BindField[string](binder)
+		case "Text9":
+			result.Text9, err = BindField[string](binder)
+		case "Text10":
+			result.Text10, err = BindField[string](binder)
+		case "Email1":
+			result.Email1, err = BindField[string](binder)
+		case "Email2":
+			result.Email2, err = BindField[string](binder)
+		case "Email3":
+			result.Email3, err = BindField[string](binder)
+		case "Email4":
+			result.Email4, err = BindField[string](binder)
+		case "Email5":
+			result.Email5, err = BindField[string](binder)
+		case "Number1":
+			result.Number1, err = BindField[int](binder)
+		case "Number2":
+			result.Number2, err = BindField[int](binder)
+		case "Number3":
+			result.Number3, err = BindField[int](binder)
+		case "Number4":
+			result.Number4, err = BindField[int](binder)
+		case "Number5":
+			result.Number5, err = BindField[int](binder)
+		case "Number6":
+			result.Number6, err = BindField[int](binder)
+		case "Number7":
+			result.Number7, err = BindField[int](binder)
+		case "Number8":
+			result.Number8, err = BindField[int](binder)
+		case "Number9":
+			result.Number9, err = BindField[int](binder)
+		case "Number10":
+			result.Number10, err = BindField[int](binder)
+		case "Amount1":
+			result.Amount1, err = BindField[float64](binder)
+		case "Amount2":
+			result.Amount2, err = BindField[float64](binder)
+		case "Amount3":
+			result.Amount3, err = BindField[float64](binder)
+		case "Amount4":
+			result.Amount4, err = BindField[float64](binder)
+		case "Amount5":
+			result.Amount5, err = BindField[float64](binder)
+		}
+		if err != nil {
+			return result, err
+		}
+	}
+	result.Form = binder.Form()
+	return result, nil
+}
+
+func CreateTestModelFromTestForm(src *testForm) testModel {
+	var result testModel
+	result.Roles = src.Roles.Value
+	result.Name = src.Name.Value
+	result.Quantity = src.Quantity.Value
+	result.Amount = src.Amount.Value
+	result.Checked = src.Checked.Value
+	return result
+}
+
+func CreateTestOptionalModelFromTestForm(src *testForm) testOptionalModel {
+	var result testOptionalModel
+	if src.Quantity.Present {
+		var value int
+		value = src.Quantity.Value
+		result.Quantity = &value
+	}
+	if src.Amount.Present {
+		var value float64
+		value = src.Amount.Value
+		result.Amount = &value
+	}
+	return result
+}
+
+func CreateTestInvoiceModelFromTestInvoiceForm(src *testInvoiceForm) testInvoiceModel {
+	var result testInvoiceModel
+	result.Lines = make([]testLineModel, len(src.Lines.Value))
+	for i0 := range src.Lines.Value {
+		result.Lines[i0] = CreateTestLineModelFromTestLineForm(&src.Lines.Value[i0])
+	}
+	result.Address = CreateTestAddressModelFromTestAddressForm(&src.Address.Value)
+	return result
+}
+
+func CreateTestCustomModelFromTestCustomForm(src *testCustomForm) testCustomModel {
+	var result testCustomModel
+	result.Owner = src.Owner.Value
+	result.Color = src.Color.Value
+	result.Slug = string(src.Slug.Value)
+	return result
+}
+
+func CreateTestEnumModelFromTestEnumForm(src *testEnumForm) testEnumModel {
+	var result testEnumModel
+	result.Status = src.Status.Value
+	result.Level = src.Level.Value
+	return result
+}
+
+func CreateTestLineModelFromTestLineForm(src *testLineForm) testLineModel {
+	var result testLineModel
+	result.Description = src.Description.Value
+	result.Qty = src.Qty.Value
+	return result
+}
+
+func CreateTestAddressModelFromTestAddressForm(src *testAddressForm) testAddressModel {
+	var result testAddressModel
+	result.Street = src.Street.Value
+	result.City = src.City.Value
+	return result
+}
@@ -4,7 +4,10 @@ go 1.21
 
 require (
 	github.com/iancoleman/strcase v0.3.0
+	github.com/rivo/uniseg v0.4.7
 	github.com/stretchr/testify v1.8.4
+	golang.org/x/net v0.35.0
+	golang.org/x/text v0.22.0
 )
 
 require (
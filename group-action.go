@@ -0,0 +1,135 @@
+package form
+
+import (
+	"fmt"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	
+	"github.com/creamsensation/gox"
+)
+
+const (
+	GroupAdd    = "__group_add__"
+	GroupRemove = "__group_remove__"
+)
+
+func GroupNode[T any](field Field[T], nodes ...gox.Node) gox.Node {
+	return gox.Div(
+		gox.Id(getFieldId(field.Id, field.Name)),
+		gox.Fragment(nodes...),
+	)
+}
+
+func GroupAddButton[T any](form Form, field Field[T], nodes ...gox.Node) gox.Node {
+	return createGroupActionButton(form, getFieldId(field.Id, field.Name), GroupAdd, field.Name, nodes...)
+}
+
+func GroupRemoveButton[T any](form Form, field Field[T], index int, nodes ...gox.Node) gox.Node {
+	return createGroupActionButton(
+		form, getFieldId(field.Id, field.Name), GroupRemove, fmt.Sprintf("%s[%d]", field.Name, index), nodes...,
+	)
+}
+
+func createGroupActionButton(form Form, groupId, action, value string, nodes ...gox.Node) gox.Node {
+	method := strings.ToLower(form.Method)
+	if len(method) == 0 || method == strings.ToLower(http.MethodGet) {
+		method = strings.ToLower(http.MethodPost)
+	}
+	return gox.Button(
+		gox.Type("submit"),
+		gox.Name(action),
+		gox.Value(value),
+		gox.Attribute("formnovalidate", "formnovalidate"),
+		gox.If(
+			form.Hx,
+			gox.Attribute("hx-"+method, form.Action),
+			gox.Attribute("hx-target", "#"+groupId),
+			gox.Attribute("hx-select", "#"+groupId),
+			gox.Attribute("hx-swap", "outerHTML"),
+		),
+		gox.Fragment(nodes...),
+	)
+}
+
+func isGroupAction(req *http.Request) bool {
+	if req == nil || req.Form == nil {
+		return false
+	}
+	return len(req.Form.Get(GroupAdd)) > 0 || len(req.Form.Get(GroupRemove)) > 0
+}
+
+func processGroupAction(b *Builder, data url.Values) {
+	if path := data.Get(GroupAdd); len(path) > 0 {
+		if field := findGroupField(b.fields, path); field != nil && field.multiple {
+			index := 0
+			if count := len(field.group.items); count > 0 {
+				index = field.group.items[count-1].index + 1
+			}
+			field.group.item(index)
+		}
+	}
+	if path := data.Get(GroupRemove); len(path) > 0 {
+		groupPath, position, ok := parseGroupItemPath(path)
+		if !ok {
+			return
+		}
+		if field := findGroupField(b.fields, groupPath); field != nil && field.multiple {
+			field.group.remove(position)
+		}
+	}
+}
+
+func (g *fieldGroup) remove(position int) {
+	if position < 0 || position >= len(g.items) {
+		return
+	}
+	g.items = append(g.items[:position], g.items[position+1:]...)
+}
+
+func parseGroupItemPath(path string) (string, int, bool) {
+	if !strings.HasSuffix(path, "]") {
+		return "", 0, false
+	}
+	start := strings.LastIndex(path, "[")
+	if start < 1 {
+		return "", 0, false
+	}
+	position, err := strconv.Atoi(path[start+1 : len(path)-1])
+	if err != nil {
+		return "", 0, false
+	}
+	return path[:start], position, true
+}
+
+func findGroupField(fields []*FieldBuilder, path string) *FieldBuilder {
+	for _, field := range fields {
+		if field.dataType != fieldDataTypeGroup {
+			continue
+		}
+		if field.name == path {
+			return field
+		}
+		if !field.multiple {
+			if rest, ok := strings.CutPrefix(path, field.name+"."); ok {
+				return findGroupField(field.group.item(0).fields, rest)
+			}
+			continue
+		}
+		rest, ok := strings.CutPrefix(path, field.name+"[")
+		if !ok {
+			continue
+		}
+		positionPart, rest, ok := strings.Cut(rest, "].")
+		if !ok {
+			continue
+		}
+		position, err := strconv.Atoi(positionPart)
+		if err != nil || position < 0 || position >= len(field.group.items) {
+			continue
+		}
+		return findGroupField(field.group.items[position].fields, rest)
+	}
+	return nil
+}
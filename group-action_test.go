@@ -0,0 +1,100 @@
+package form
+
+import (
+	"net/http"
+	"net/url"
+	"testing"
+	
+	"github.com/stretchr/testify/assert"
+	
+	"github.com/creamsensation/gox"
+)
+
+func TestGroupAction(t *testing.T) {
+	t.Run(
+		"add item", func(t *testing.T) {
+			req := testCreateValuesRequest(
+				url.Values{
+					"lines[0].description": {"First"},
+					"lines[0].qty":         {"2"},
+					GroupAdd:               {"lines"},
+				},
+			)
+			form, err := Build[testInvoiceForm](testCreateGroupActionBuilder().Request(req))
+			assert.Nil(t, err)
+			assert.False(t, form.Submitted)
+			assert.True(t, form.Valid)
+			assert.Equal(t, 2, len(form.Lines.Value))
+			assert.Equal(t, "First", form.Lines.Value[0].Description.Value)
+			assert.Equal(t, "lines[1].description", form.Lines.Value[1].Description.Name)
+			assert.Equal(t, 0, len(form.Lines.Value[1].Description.Messages))
+		},
+	)
+	t.Run(
+		"remove item", func(t *testing.T) {
+			req := testCreateValuesRequest(
+				url.Values{
+					"lines[0].description": {"First"},
+					"lines[1].description": {"Second"},
+					"lines[2].description": {"Third"},
+					GroupRemove:            {"lines[1]"},
+				},
+			)
+			form, err := Build[testInvoiceForm](testCreateGroupActionBuilder().Request(req))
+			assert.Nil(t, err)
+			assert.False(t, form.Submitted)
+			assert.Equal(t, 2, len(form.Lines.Value))
+			assert.Equal(t, "Third", form.Lines.Value[1].Description.Value)
+			assert.Equal(t, "lines[1].description", form.Lines.Value[1].Description.Name)
+		},
+	)
+	t.Run(
+		"keep values after failed validation", func(t *testing.T) {
+			req := testCreateValuesRequest(
+				url.Values{
+					"lines[0].description": {""},
+					"lines[0].qty":         {"3"},
+				},
+			)
+			form, err := Build[testInvoiceForm](testCreateGroupActionBuilder().Request(req))
+			assert.Nil(t, err)
+			assert.True(t, form.Submitted)
+			assert.False(t, form.Valid)
+			assert.Equal(t, 3, form.Lines.Value[0].Qty.Value)
+		},
+	)
+	t.Run(
+		"find nested group", func(t *testing.T) {
+			fields := []*FieldBuilder{
+				Add("lines").Multiple().With(
+					Group(
+						Add("parts").Multiple().With(Group(Add("name").With(Text()))),
+					),
+				),
+			}
+			fields[0].group.item(0)
+			assert.Equal(t, fields[0], findGroupField(fields, "lines"))
+			assert.Equal(t, "parts", findGroupField(fields, "lines[0].parts").name)
+			assert.Nil(t, findGroupField(fields, "lines[1].parts"))
+			path, position, ok := parseGroupItemPath("lines[0].parts[2]")
+			assert.True(t, ok)
+			assert.Equal(t, "lines[0].parts", path)
+			assert.Equal(t, 2, position)
+		},
+	)
+	t.Run(
+		"hx buttons", func(t *testing.T) {
+			form := Form{Method: http.MethodPost, Action: testAction, Hx: true}
+			field := Field[[]testLineForm]{Name: "lines"}
+			add := gox.Render(GroupAddButton(form, field, gox.Text("Add")))
+			assert.Contains(t, add, `name="`+GroupAdd+`"`)
+			assert.Contains(t, add, `value="lines"`)
+			assert.Contains(t, add, `hx-post="`+testAction+`"`)
+			assert.Contains(t, add, `hx-target="#lines"`)
+			remove := gox.Render(GroupRemoveButton(Form{}, field, 1, gox.Text("Remove")))
+			assert.Contains(t, remove, `value="lines[1]"`)
+			assert.NotContains(t, remove, `hx-post`)
+			assert.Contains(t, gox.Render(GroupNode(Field[any]{Name: "lines[0].parts"})), `id="lines-0-parts"`)
+		},
+	)
+}
@@ -0,0 +1,170 @@
+package form
+
+import (
+	"fmt"
+	"net/url"
+	"reflect"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+type fieldGroup struct {
+	fields []*FieldBuilder
+	items  []*groupItem
+}
+
+type groupItem struct {
+	index  int
+	fields []*FieldBuilder
+}
+
+func Group(fields ...*FieldBuilder) FieldConfig {
+	return FieldConfig{
+		fieldType: fieldTypeGroup,
+		dataType:  fieldDataTypeGroup,
+		fields:    fields,
+	}
+}
+
+func createFieldGroup(b *FieldBuilder, fieldType, dataType string, fields ...*FieldBuilder) {
+	b.fieldType = fieldType
+	b.dataType = dataType
+	b.group = &fieldGroup{
+		fields: fields,
+		items:  make([]*groupItem, 0),
+	}
+}
+
+func (g *fieldGroup) clone() *fieldGroup {
+	return &fieldGroup{
+		fields: g.fields,
+		items:  make([]*groupItem, 0),
+	}
+}
+
+func (g *fieldGroup) item(index int) *groupItem {
+	for _, item := range g.items {
+		if item.index == index {
+			return item
+		}
+	}
+	item := &groupItem{
+		index:  index,
+		fields: cloneFields(g.fields),
+	}
+	g.items = append(g.items, item)
+	sort.SliceStable(
+		g.items, func(i, j int) bool {
+			return g.items[i].index < g.items[j].index
+		},
+	)
+	return item
+}
+
+func (g *fieldGroup) isValid() bool {
+	for _, item := range g.items {
+		for _, field := range item.fields {
+			if !field.valid {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func cloneFields(fields []*FieldBuilder) []*FieldBuilder {
+	result := make([]*FieldBuilder, len(fields))
+	for i, field := range fields {
+		result[i] = field.clone()
+	}
+	return result
+}
+
+func parseGroupKey(fb *FieldBuilder, key string) (int, string, bool) {
+	if !fb.multiple {
+		name, ok := strings.CutPrefix(key, fb.name+".")
+		return 0, name, ok && len(name) > 0
+	}
+	rest, ok := strings.CutPrefix(key, fb.name+"[")
+	if !ok {
+		return 0, "", false
+	}
+	indexPart, name, ok := strings.Cut(rest, "].")
+	if !ok || len(name) == 0 {
+		return 0, "", false
+	}
+	index, err := strconv.Atoi(indexPart)
+	if err != nil || index < 0 {
+		return 0, "", false
+	}
+	return index, name, true
+}
+
+func parseGroupData(fb *FieldBuilder, data url.Values) map[int]url.Values {
+	result := make(map[int]url.Values)
+	for key, values := range data {
+		index, name, ok := parseGroupKey(fb, key)
+		if !ok {
+			continue
+		}
+		if _, exists := result[index]; !exists {
+			result[index] = make(url.Values)
+		}
+		result[index][name] = values
+	}
+	return result
+}
+
+func parseGroupFiles(fb *FieldBuilder, files map[string][]Multipart) map[int]map[string][]Multipart {
+	result := make(map[int]map[string][]Multipart)
+	for key, items := range files {
+		index, name, ok := parseGroupKey(fb, key)
+		if !ok {
+			continue
+		}
+		if _, exists := result[index]; !exists {
+			result[index] = make(map[string][]Multipart)
+		}
+		result[index][name] = items
+	}
+	return result
+}
+
+func getGroupItemPath(fb *FieldBuilder, position int) string {
+	if !fb.multiple {
+		return fb.fullName()
+	}
+	return fmt.Sprintf("%s[%d]", fb.fullName(), position)
+}
+
+func createGroupField(fieldType reflect.Type, fb *FieldBuilder) (reflect.Value, []string) {
+	messages := fb.errors
+	field := createReflectField(fieldType, fb, messages)
+	valueField := field.FieldByName(valueFieldName)
+	if !valueField.IsValid() {
+		return field, messages
+	}
+	if fb.multiple && valueField.Kind() == reflect.Slice && valueField.Type().Elem().Kind() == reflect.Struct {
+		value := reflect.MakeSlice(valueField.Type(), 0, len(fb.group.items))
+		for i, item := range fb.group.items {
+			itemRef := reflect.New(valueField.Type().Elem())
+			buildGroupItem(itemRef, item, getGroupItemPath(fb, i))
+			value = reflect.Append(value, itemRef.Elem())
+		}
+		valueField.Set(value)
+	}
+	if !fb.multiple && valueField.Kind() == reflect.Struct {
+		itemRef := reflect.New(valueField.Type())
+		buildGroupItem(itemRef, fb.group.item(0), getGroupItemPath(fb, 0))
+		valueField.Set(itemRef.Elem())
+	}
+	return field, messages
+}
+
+func buildGroupItem(itemRef reflect.Value, item *groupItem, path string) {
+	for _, field := range item.fields {
+		field.path = path + "." + field.name
+	}
+	buildFormFields(itemRef, item.fields)
+}
@@ -0,0 +1,135 @@
+package form
+
+import (
+	"net/url"
+	"testing"
+	
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGroup(t *testing.T) {
+	t.Run(
+		"parse group key", func(t *testing.T) {
+			lines := Add("lines").Multiple().With(Group())
+			index, name, ok := parseGroupKey(lines, "lines[12].description")
+			assert.True(t, ok)
+			assert.Equal(t, 12, index)
+			assert.Equal(t, "description", name)
+			_, name, ok = parseGroupKey(lines, "lines[0].address.street")
+			assert.True(t, ok)
+			assert.Equal(t, "address.street", name)
+			_, _, ok = parseGroupKey(lines, "lines[x].description")
+			assert.False(t, ok)
+			_, _, ok = parseGroupKey(lines, "lines.description")
+			assert.False(t, ok)
+			address := Add("address").With(Group())
+			_, name, ok = parseGroupKey(address, "address.street")
+			assert.True(t, ok)
+			assert.Equal(t, "street", name)
+		},
+	)
+	t.Run(
+		"build items", func(t *testing.T) {
+			req := testCreateValuesRequest(
+				url.Values{
+					"lines[0].description": {"First"},
+					"lines[0].qty":         {"2"},
+					"lines[3].description": {"Second"},
+					"lines[3].qty":         {"4"},
+					"address.street":       {"Main"},
+					"address.city":         {"Prague"},
+				},
+			)
+			form, err := Build[testInvoiceForm](testCreateInvoiceBuilder().Request(req))
+			assert.Nil(t, err)
+			assert.True(t, form.Valid)
+			assert.Equal(t, 2, len(form.Lines.Value))
+			assert.Equal(t, "First", form.Lines.Value[0].Description.Value)
+			assert.Equal(t, 4, form.Lines.Value[1].Qty.Value)
+			assert.Equal(t, "lines[1].qty", form.Lines.Value[1].Qty.Name)
+			assert.Equal(t, "Main", form.Address.Value.Street.Value)
+			assert.Equal(t, "address.city", form.Address.Value.City.Name)
+		},
+	)
+	t.Run(
+		"item errors", func(t *testing.T) {
+			req := testCreateValuesRequest(
+				url.Values{
+					"lines[0].description": {"First"},
+					"lines[0].qty":         {"2"},
+					"lines[1].qty":         {"0"},
+					"address.street":       {"Main"},
+				},
+			)
+			form, err := Build[testInvoiceForm](testCreateInvoiceBuilder().Request(req))
+			assert.Nil(t, err)
+			assert.False(t, form.Valid)
+			assert.Equal(t, 0, len(form.Lines.Messages))
+			assert.Equal(t, 0, len(form.Lines.Value[0].Qty.Messages))
+			assert.Equal(t, []string{defaultRequiredMessage}, form.Lines.Value[1].Description.Messages)
+			assert.Equal(t, []string{defaultMinNumberMessage}, form.Lines.Value[1].Qty.Messages)
+		},
+	)
+	t.Run(
+		"item count", func(t *testing.T) {
+			req := testCreateValuesRequest(url.Values{"address.street": {"Main"}})
+			form, err := Build[testInvoiceForm](testCreateInvoiceBuilder().Request(req))
+			assert.Nil(t, err)
+			assert.False(t, form.Valid)
+			assert.Equal(t, []string{defaultMinItemsMessage}, form.Lines.Messages)
+			values := url.Values{"address.street": {"Main"}}
+			for _, key := range []string{"lines[0].qty", "lines[1].qty", "lines[2].qty", "lines[3].qty"} {
+				values.Set(key, "1")
+			}
+			form, err = Build[testInvoiceForm](testCreateInvoiceBuilder().Request(testCreateValuesRequest(values)))
+			assert.Nil(t, err)
+			assert.Equal(t, []string{defaultMaxItemsMessage}, form.Lines.Messages)
+		},
+	)
+	t.Run(
+		"create struct", func(t *testing.T) {
+			req := testCreateValuesRequest(
+				url.Values{
+					"lines[0].description": {"First"},
+					"lines[0].qty":         {"2"},
+					"address.street":       {"Main"},
+				},
+			)
+			form, err := Build[testInvoiceForm](testCreateInvoiceBuilder().Request(req))
+			assert.Nil(t, err)
+			assert.Equal(
+				t,
+				testInvoiceModel{
+					Lines:   []testLineModel{{Description: "First", Qty: 2}},
+					Address: testAddressModel{Street: "Main"},
+				},
+				CreateStruct[testInvoiceForm, testInvoiceModel](&form),
+			)
+		},
+	)
+	t.Run(
+		"required single group", func(t *testing.T) {
+			form, err := Build[testInvoiceForm](
+				New(
+					Add("address").With(
+						Group(Add("street").With(Text()), Add("city").With(Text())),
+						Validate.Required(),
+					),
+				).Request(testCreateValuesRequest(url.Values{"other": {"value"}})),
+			)
+			assert.Nil(t, err)
+			assert.False(t, form.Valid)
+			assert.Equal(t, []string{defaultRequiredMessage}, form.Address.Messages)
+			form, err = Build[testInvoiceForm](
+				New(
+					Add("address").With(
+						Group(Add("street").With(Text()), Add("city").With(Text())),
+						Validate.Required(),
+					),
+				).Request(testCreateValuesRequest(url.Values{"address.city": {"Prague"}})),
+			)
+			assert.Nil(t, err)
+			assert.True(t, form.Valid)
+		},
+	)
+}
@@ -0,0 +1,69 @@
+package form
+
+import (
+	"math"
+)
+
+type ImageRules struct {
+	MinWidth             int
+	MaxWidth             int
+	MinHeight            int
+	MaxHeight            int
+	AspectRatio          float64
+	AspectRatioTolerance float64
+	MaxMegapixels        float64
+}
+
+const (
+	defaultImageMaxMegapixels        = 40
+	defaultImageAspectRatioTolerance = 0.01
+)
+
+func (v Validators) Image(rules ...ImageRules) Validator {
+	r := ImageRules{}
+	if len(rules) > 0 {
+		r = rules[0]
+	}
+	if r.MaxMegapixels == 0 {
+		r.MaxMegapixels = defaultImageMaxMegapixels
+	}
+	if r.AspectRatioTolerance == 0 {
+		r.AspectRatioTolerance = defaultImageAspectRatioTolerance
+	}
+	return validator{
+		validatorType: validatorTypeImage,
+		value:         r,
+	}
+}
+
+func validateImage(fb *FieldBuilder, v validator) []string {
+	rules := v.value.(ImageRules)
+	return validateFiles(
+		fb, func(file Multipart) bool {
+			if file.Width == 0 || file.Height == 0 {
+				file.readImageMetadata()
+			}
+			return isImageValid(file.Width, file.Height, rules)
+		},
+	)
+}
+
+func isImageValid(width, height int, rules ImageRules) bool {
+	switch {
+	case width < 1 || height < 1:
+		return false
+	case float64(width)*float64(height) > rules.MaxMegapixels*1_000_000:
+		return false
+	case rules.MinWidth > 0 && width < rules.MinWidth:
+		return false
+	case rules.MaxWidth > 0 && width > rules.MaxWidth:
+		return false
+	case rules.MinHeight > 0 && height < rules.MinHeight:
+		return false
+	case rules.MaxHeight > 0 && height > rules.MaxHeight:
+		return false
+	case rules.AspectRatio > 0 && math.Abs(float64(width)/float64(height)-rules.AspectRatio) > rules.AspectRatioTolerance:
+		return false
+	}
+	return true
+}
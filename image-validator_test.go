@@ -0,0 +1,95 @@
+package form
+
+import (
+	"bytes"
+	"encoding/binary"
+	"hash/crc32"
+	"image"
+	"image/png"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	
+	"github.com/stretchr/testify/assert"
+)
+
+func TestImageValidator(t *testing.T) {
+	createPng := func(width, height int) []byte {
+		buf := new(bytes.Buffer)
+		assert.Nil(t, png.Encode(buf, image.NewRGBA(image.Rect(0, 0, width, height))))
+		return buf.Bytes()
+	}
+	createPngHeader := func(width, height uint32) []byte {
+		ihdr := make([]byte, 17)
+		copy(ihdr, "IHDR")
+		binary.BigEndian.PutUint32(ihdr[4:], width)
+		binary.BigEndian.PutUint32(ihdr[8:], height)
+		ihdr[12], ihdr[13] = 8, 6
+		buf := bytes.NewBufferString("\x89PNG\r\n\x1a\n")
+		assert.Nil(t, binary.Write(buf, binary.BigEndian, uint32(13)))
+		buf.Write(ihdr)
+		assert.Nil(t, binary.Write(buf, binary.BigEndian, crc32.ChecksumIEEE(ihdr)))
+		return buf.Bytes()
+	}
+	validate := func(data []byte, validators ...Validator) []string {
+		file := Multipart{Type: http.DetectContentType(data), Suffix: "png", Data: data}
+		file.readImageMetadata()
+		fb := Add("test").With(File(), validators...)
+		fb.value = file
+		fb.messages = defaultMessages
+		return validateField(fb, nil)
+	}
+	t.Run(
+		"metadata", func(t *testing.T) {
+			bodyBuf := new(bytes.Buffer)
+			bodyWriter := multipart.NewWriter(bodyBuf)
+			testFile, err := bodyWriter.CreateFormFile("test", "image.png")
+			assert.Nil(t, err)
+			_, err = testFile.Write(createPng(40, 20))
+			assert.Nil(t, err)
+			assert.Nil(t, bodyWriter.Close())
+			req := httptest.NewRequest(http.MethodPost, "/test", bodyBuf)
+			req.Header.Set(contentType, bodyWriter.FormDataContentType())
+			form, err := Build[testForm](New(Add("test").With(File(), Validate.Image())).Request(req))
+			assert.Nil(t, err)
+			assert.True(t, form.Valid)
+			assert.Equal(t, "image/png", form.Test.Value.Type)
+			assert.Equal(t, 40, form.Test.Value.Width)
+			assert.Equal(t, 20, form.Test.Value.Height)
+		},
+	)
+	t.Run(
+		"dimensions", func(t *testing.T) {
+			data := createPng(40, 20)
+			assert.Equal(t, 0, len(validate(data, Validate.Image(ImageRules{MinWidth: 40, MaxHeight: 20}))))
+			assert.Equal(t, []string{defaultMultipartMessage}, validate(data, Validate.Image(ImageRules{MinWidth: 41})))
+			assert.Equal(t, []string{defaultMultipartMessage}, validate(data, Validate.Image(ImageRules{MaxWidth: 39})))
+			assert.Equal(t, []string{defaultMultipartMessage}, validate(data, Validate.Image(ImageRules{MinHeight: 21})))
+		},
+	)
+	t.Run(
+		"aspect ratio", func(t *testing.T) {
+			data := createPng(40, 20)
+			assert.Equal(t, 0, len(validate(data, Validate.Image(ImageRules{AspectRatio: 2}))))
+			assert.Equal(t, []string{defaultMultipartMessage}, validate(data, Validate.Image(ImageRules{AspectRatio: 1})))
+		},
+	)
+	t.Run(
+		"decompression bomb", func(t *testing.T) {
+			data := createPngHeader(100_000, 100_000)
+			file := Multipart{Type: "image/png", Data: data}
+			file.readImageMetadata()
+			assert.Equal(t, 100_000, file.Width)
+			assert.Equal(t, []string{defaultMultipartMessage}, validate(data, Validate.Image()))
+			assert.Equal(
+				t, []string{defaultMultipartMessage}, validate(createPng(40, 20), Validate.Image(ImageRules{MaxMegapixels: 0.0005})),
+			)
+		},
+	)
+	t.Run(
+		"not image", func(t *testing.T) {
+			assert.Equal(t, []string{defaultMultipartMessage}, validate([]byte("test"), Validate.Image()))
+		},
+	)
+}
@@ -0,0 +1,94 @@
+package form
+
+import (
+	"unicode/utf8"
+	
+	"github.com/rivo/uniseg"
+)
+
+type LengthUnit int
+
+type length struct {
+	value int
+	unit  LengthUnit
+}
+
+const (
+	LengthRunes LengthUnit = iota
+	LengthGraphemes
+	LengthUtf16
+	LengthBytes
+)
+
+func (v Validators) MinLength(value int, unit ...LengthUnit) Validator {
+	return validator{
+		validatorType: validatorTypeMinLength,
+		value:         createLength(value, unit...),
+	}
+}
+
+func (v Validators) MaxLength(value int, unit ...LengthUnit) Validator {
+	return validator{
+		validatorType: validatorTypeMaxLength,
+		value:         createLength(value, unit...),
+	}
+}
+
+func (v Validators) MinBytes(value int) Validator {
+	return validator{
+		validatorType: validatorTypeMinLength,
+		value:         createLength(value, LengthBytes),
+	}
+}
+
+func (v Validators) MaxBytes(value int) Validator {
+	return validator{
+		validatorType: validatorTypeMaxLength,
+		value:         createLength(value, LengthBytes),
+	}
+}
+
+func createLength(value int, unit ...LengthUnit) length {
+	l := length{value: value, unit: LengthUtf16}
+	if len(unit) > 0 {
+		l.unit = unit[0]
+	}
+	return l
+}
+
+func validateLength(fb *FieldBuilder, message string, valid func(string) bool) []string {
+	errors := make([]string, 0)
+	switch fv := fb.value.(type) {
+	case []string:
+		for _, item := range fv {
+			if !valid(item) {
+				errors = append(errors, message)
+				break
+			}
+		}
+	case string:
+		if !valid(fv) {
+			errors = append(errors, message)
+		}
+	}
+	return errors
+}
+
+func getTextLength(value string, unit LengthUnit) int {
+	switch unit {
+	case LengthBytes:
+		return len(value)
+	case LengthGraphemes:
+		return uniseg.GraphemeClusterCount(value)
+	case LengthUtf16:
+		n := 0
+		for _, r := range value {
+			n++
+			if r > 0xffff {
+				n++
+			}
+		}
+		return n
+	}
+	return utf8.RuneCountInString(value)
+}
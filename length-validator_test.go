@@ -0,0 +1,69 @@
+package form
+
+import (
+	"testing"
+	
+	"github.com/stretchr/testify/assert"
+)
+
+func TestLengthValidator(t *testing.T) {
+	validate := func(value string, v Validator) []string {
+		form, err := Build[testForm](New(Add("name").With(Text(value), v)))
+		assert.Nil(t, err)
+		return form.Name.Messages
+	}
+	t.Run(
+		"text length", func(t *testing.T) {
+			for _, item := range []struct {
+				value string
+				unit  LengthUnit
+				count int
+			}{
+				{"abc", LengthRunes, 3},
+				{"Žluťoučký", LengthRunes, 9},
+				{"Žluťoučký", LengthBytes, 13},
+				{"Žluťoučký", LengthUtf16, 9},
+				{"e\u0301", LengthRunes, 2},
+				{"e\u0301", LengthGraphemes, 1},
+				{"\U0001f600", LengthUtf16, 2},
+				{"\U0001f468\u200d\U0001f469\u200d\U0001f467", LengthGraphemes, 1},
+				{"\U0001f44d\U0001f3fd", LengthGraphemes, 1},
+				{"\U0001f1e8\U0001f1ff\U0001f1f8\U0001f1f0", LengthGraphemes, 2},
+				{"a\r\nb", LengthGraphemes, 3},
+			} {
+				assert.Equal(t, item.count, getTextLength(item.value, item.unit), item.value)
+			}
+		},
+	)
+	t.Run(
+		"min max count characters", func(t *testing.T) {
+			assert.Equal(t, 0, len(validate("Žluťoučký", Validate.Max(9))))
+			assert.Equal(t, []string{defaultMaxTextMessage}, validate("Žluťoučký", Validate.Max(8)))
+			assert.Equal(t, 0, len(validate("Žluť", Validate.Min(4))))
+			assert.Equal(t, []string{defaultMinTextMessage}, validate("Žlu", Validate.Min(4)))
+		},
+	)
+	t.Run(
+		"length validators", func(t *testing.T) {
+			assert.Equal(t, 0, len(validate("Žluťoučký", Validate.MaxLength(9))))
+			assert.Equal(t, []string{defaultMaxTextMessage}, validate("e\u0301e\u0301", Validate.MaxLength(3)))
+			assert.Equal(t, 0, len(validate("e\u0301e\u0301", Validate.MaxLength(3, LengthGraphemes))))
+			assert.Equal(t, []string{defaultMinTextMessage}, validate("e\u0301", Validate.MinLength(2, LengthGraphemes)))
+			assert.Equal(t, []string{defaultMinTextMessage}, validate("", Validate.MinLength(1)))
+			assert.Equal(t, []string{defaultMaxTextMessage}, validate("\U0001f600", Validate.MaxLength(1)))
+			assert.Equal(t, 0, len(validate("\U0001f600", Validate.MaxLength(1, LengthRunes))))
+			assert.Equal(t, []string{defaultMaxTextMessage}, validate("Žluťoučký", Validate.MaxBytes(9)))
+			assert.Equal(t, 0, len(validate("Žluťoučký", Validate.MaxBytes(13))))
+			assert.Equal(t, []string{defaultMinTextMessage}, validate("Ž", Validate.MinBytes(3)))
+		},
+	)
+	t.Run(
+		"multiple values", func(t *testing.T) {
+			form, err := Build[testForm](
+				New(Add("roles").Multiple().With(Text("čeština", "žluťoučký"), Validate.MaxLength(8))),
+			)
+			assert.Nil(t, err)
+			assert.Equal(t, []string{defaultMaxTextMessage}, form.Roles.Messages)
+		},
+	)
+}
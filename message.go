@@ -1,36 +1,78 @@
 package form
 
 type Messages struct {
-	Email     string `json:"email" toml:"email" yaml:"email"`
-	Required  string `json:"required" toml:"required" yaml:"required"`
-	MinText   string `json:"minText" toml:"minText" yaml:"minText"`
-	MaxText   string `json:"maxText" toml:"maxText" yaml:"maxText"`
-	MinNumber string `json:"minNumber" toml:"minNumber" yaml:"minNumber"`
-	MaxNumber string `json:"maxNumber" toml:"maxNumber" yaml:"maxNumber"`
-	Multipart string `json:"multipart" toml:"multipart" yaml:"multipart"`
-	Invalid   string `json:"invalid" toml:"invalid" yaml:"invalid"`
+	Email      string `json:"email" toml:"email" yaml:"email"`
+	Required   string `json:"required" toml:"required" yaml:"required"`
+	MinText    string `json:"minText" toml:"minText" yaml:"minText"`
+	MaxText    string `json:"maxText" toml:"maxText" yaml:"maxText"`
+	MinNumber  string `json:"minNumber" toml:"minNumber" yaml:"minNumber"`
+	MaxNumber  string `json:"maxNumber" toml:"maxNumber" yaml:"maxNumber"`
+	Multipart  string `json:"multipart" toml:"multipart" yaml:"multipart"`
+	Invalid    string `json:"invalid" toml:"invalid" yaml:"invalid"`
+	MinItems   string `json:"minItems" toml:"minItems" yaml:"minItems"`
+	MaxItems   string `json:"maxItems" toml:"maxItems" yaml:"maxItems"`
+	Positive   string `json:"positive" toml:"positive" yaml:"positive"`
+	Decimals   string `json:"decimals" toml:"decimals" yaml:"decimals"`
+	Currency   string `json:"currency" toml:"currency" yaml:"currency"`
+	Url        string `json:"url" toml:"url" yaml:"url"`
+	Uuid       string `json:"uuid" toml:"uuid" yaml:"uuid"`
+	Phone      string `json:"phone" toml:"phone" yaml:"phone"`
+	Iban       string `json:"iban" toml:"iban" yaml:"iban"`
+	Ico        string `json:"ico" toml:"ico" yaml:"ico"`
+	Dic        string `json:"dic" toml:"dic" yaml:"dic"`
+	CreditCard string `json:"creditCard" toml:"creditCard" yaml:"creditCard"`
+	HexColor   string `json:"hexColor" toml:"hexColor" yaml:"hexColor"`
+	PostalCode string `json:"postalCode" toml:"postalCode" yaml:"postalCode"`
 }
 
 const (
-	defaultRequiredMessage  = "field is required"
-	defaultEmailMessage     = "email value is invalid"
-	defaultMinTextMessage   = "field length is smaller than should be"
-	defaultMaxTextMessage   = "field length is higher than should be"
-	defaultMinNumberMessage = "field value is smaller than should be"
-	defaultMaxNumberMessage = "field value is higher than should be"
-	defaultMultipartMessage = "invalid file"
-	defaultInvalidMessage   = "invalid value"
+	defaultRequiredMessage   = "field is required"
+	defaultEmailMessage      = "email value is invalid"
+	defaultMinTextMessage    = "field length is smaller than should be"
+	defaultMaxTextMessage    = "field length is higher than should be"
+	defaultMinNumberMessage  = "field value is smaller than should be"
+	defaultMaxNumberMessage  = "field value is higher than should be"
+	defaultMultipartMessage  = "invalid file"
+	defaultInvalidMessage    = "invalid value"
+	defaultMinItemsMessage   = "field has fewer items than should have"
+	defaultMaxItemsMessage   = "field has more items than should have"
+	defaultPositiveMessage   = "field value must be positive"
+	defaultDecimalsMessage   = "field value has too many decimal places"
+	defaultCurrencyMessage   = "currency is not allowed"
+	defaultUrlMessage        = "url value is invalid"
+	defaultUuidMessage       = "uuid value is invalid"
+	defaultPhoneMessage      = "phone number is invalid"
+	defaultIbanMessage       = "iban value is invalid"
+	defaultIcoMessage        = "ico value is invalid"
+	defaultDicMessage        = "dic value is invalid"
+	defaultCreditCardMessage = "credit card number is invalid"
+	defaultHexColorMessage   = "color value is invalid"
+	defaultPostalCodeMessage = "postal code is invalid"
 )
 
 var (
 	defaultMessages = Messages{
-		Email:     defaultEmailMessage,
-		Required:  defaultRequiredMessage,
-		MinText:   defaultMinTextMessage,
-		MaxText:   defaultMaxTextMessage,
-		MinNumber: defaultMinNumberMessage,
-		MaxNumber: defaultMaxNumberMessage,
-		Multipart: defaultMultipartMessage,
-		Invalid:   defaultInvalidMessage,
+		Email:      defaultEmailMessage,
+		Required:   defaultRequiredMessage,
+		MinText:    defaultMinTextMessage,
+		MaxText:    defaultMaxTextMessage,
+		MinNumber:  defaultMinNumberMessage,
+		MaxNumber:  defaultMaxNumberMessage,
+		Multipart:  defaultMultipartMessage,
+		Invalid:    defaultInvalidMessage,
+		MinItems:   defaultMinItemsMessage,
+		MaxItems:   defaultMaxItemsMessage,
+		Positive:   defaultPositiveMessage,
+		Decimals:   defaultDecimalsMessage,
+		Currency:   defaultCurrencyMessage,
+		Url:        defaultUrlMessage,
+		Uuid:       defaultUuidMessage,
+		Phone:      defaultPhoneMessage,
+		Iban:       defaultIbanMessage,
+		Ico:        defaultIcoMessage,
+		Dic:        defaultDicMessage,
+		CreditCard: defaultCreditCardMessage,
+		HexColor:   defaultHexColorMessage,
+		PostalCode: defaultPostalCodeMessage,
 	}
 )
@@ -0,0 +1,91 @@
+package form
+
+import (
+	"slices"
+	"strings"
+)
+
+func (v Validators) Positive() Validator {
+	return validator{
+		validatorType: validatorTypePositive,
+	}
+}
+
+func (v Validators) Decimals(places ...int) Validator {
+	value := -1
+	if len(places) > 0 {
+		value = places[0]
+	}
+	return validator{
+		validatorType: validatorTypeDecimals,
+		value:         value,
+	}
+}
+
+func (v Validators) Currencies(currencies ...string) Validator {
+	return validator{
+		validatorType: validatorTypeCurrencies,
+		value: convertSlice[string, string](
+			currencies, func(currency string) string {
+				return strings.ToUpper(currency)
+			},
+		),
+	}
+}
+
+func validatePositive(fb *FieldBuilder) []string {
+	errors := make([]string, 0)
+	if !fb.isNumber() || !fb.present {
+		return errors
+	}
+	for _, item := range getValueItems(fb.value) {
+		if result, ok := compareNumber(item, 0); ok && result <= 0 {
+			errors = append(errors, fb.messages.Positive)
+			break
+		}
+	}
+	return errors
+}
+
+func validateDecimals(fb *FieldBuilder, v validator) []string {
+	errors := make([]string, 0)
+	if !fb.present {
+		return errors
+	}
+	for _, item := range getValueItems(fb.value) {
+		places := v.value.(int)
+		var value Decimal
+		switch iv := item.(type) {
+		case Decimal:
+			value = iv
+		case Amount:
+			value = iv.Value
+			if minor, ok := currencyMinorUnits[iv.Currency]; ok && places < 0 {
+				places = minor
+			}
+		default:
+			continue
+		}
+		if places > -1 && value.Normalize().Scale() > places {
+			errors = append(errors, fb.messages.Decimals)
+			break
+		}
+	}
+	return errors
+}
+
+func validateCurrencies(fb *FieldBuilder, v validator) []string {
+	errors := make([]string, 0)
+	currencies := v.value.([]string)
+	for _, item := range getValueItems(fb.value) {
+		amount, ok := item.(Amount)
+		if !ok || len(amount.Currency) == 0 {
+			continue
+		}
+		if !slices.Contains(currencies, amount.Currency) {
+			errors = append(errors, fb.messages.Currency)
+			break
+		}
+	}
+	return errors
+}
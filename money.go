@@ -0,0 +1,277 @@
+package form
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+	"unicode"
+)
+
+type Amount struct {
+	Value    Decimal
+	Currency string
+}
+
+type moneyLocale struct {
+	decimal       string
+	group         string
+	currencyFirst bool
+}
+
+const (
+	defaultMoneyLocale  = "en-US"
+	moneyCurrencySuffix = ".currency"
+	moneySpace          = "\u00a0"
+)
+
+var (
+	errInvalidCurrency = errors.New("invalid currency")
+	errInvalidAmount   = errors.New("invalid amount")
+)
+
+var (
+	moneyLocales = map[string]moneyLocale{
+		"en-US": {decimal: ".", group: ",", currencyFirst: true},
+		"en-GB": {decimal: ".", group: ",", currencyFirst: true},
+		"cs-CZ": {decimal: ",", group: " "},
+		"sk-SK": {decimal: ",", group: " "},
+		"de-DE": {decimal: ",", group: "."},
+		"fr-FR": {decimal: ",", group: " "},
+	}
+	moneyLanguages = map[string]string{
+		"en": "en-US",
+		"cs": "cs-CZ",
+		"sk": "sk-SK",
+		"de": "de-DE",
+		"fr": "fr-FR",
+	}
+	moneySpaces        = []string{" ", "\u00a0", "\u202f"}
+	currencyMinorUnits = map[string]int{
+		"AED": 2, "AUD": 2, "BGN": 2, "BHD": 3, "BRL": 2, "CAD": 2, "CHF": 2, "CLP": 0, "CNY": 2, "CZK": 2,
+		"DKK": 2, "EUR": 2, "GBP": 2, "HKD": 2, "HUF": 2, "IDR": 2, "ILS": 2, "INR": 2, "ISK": 0, "JOD": 3,
+		"JPY": 0, "KRW": 0, "KWD": 3, "MXN": 2, "NOK": 2, "NZD": 2, "OMR": 3, "PLN": 2, "RON": 2, "RSD": 2,
+		"SAR": 2, "SEK": 2, "SGD": 2, "THB": 2, "TND": 3, "TRY": 2, "UAH": 2, "USD": 2, "VND": 0, "ZAR": 2,
+	}
+	currencySymbols = map[string]string{
+		"$":  "USD",
+		"€":  "EUR",
+		"£":  "GBP",
+		"¥":  "JPY",
+		"Kč": "CZK",
+		"zł": "PLN",
+		"Ft": "HUF",
+	}
+)
+
+func Money(value ...Amount) FieldConfig {
+	return FieldConfig{
+		fieldType: fieldTypeMoney,
+		dataType:  fieldDataTypeMoney,
+		value:     value,
+	}
+}
+
+func NewAmount(value Decimal, currency string) Amount {
+	return Amount{Value: value, Currency: strings.ToUpper(currency)}
+}
+
+func ParseAmount(value, currency, locale string) (Amount, error) {
+	currency = strings.ToUpper(strings.TrimSpace(currency))
+	text := strings.TrimSpace(value)
+	code, text := cutCurrency(text)
+	if len(code) > 0 && len(currency) > 0 && code != currency {
+		return Amount{}, fmt.Errorf("%w: %s", errInvalidCurrency, value)
+	}
+	if len(code) > 0 {
+		currency = code
+	}
+	if _, ok := currencyMinorUnits[currency]; len(currency) > 0 && !ok {
+		return Amount{}, fmt.Errorf("%w: %s", errInvalidCurrency, currency)
+	}
+	number, err := normalizeMoneyNumber(text, getMoneyLocale(locale))
+	if err != nil {
+		return Amount{}, fmt.Errorf("%w: %s", err, value)
+	}
+	d, err := ParseDecimal(number)
+	if err != nil {
+		return Amount{}, fmt.Errorf("%w: %s", errInvalidAmount, value)
+	}
+	return Amount{Value: d, Currency: currency}, nil
+}
+
+func (a Amount) Format(locale string) string {
+	result := a.formatNumber(locale)
+	if len(a.Currency) == 0 {
+		return result
+	}
+	if getMoneyLocale(locale).currencyFirst {
+		return a.Currency + moneySpace + result
+	}
+	return result + moneySpace + a.Currency
+}
+
+func (a Amount) formatNumber(locale string) string {
+	format := getMoneyLocale(locale)
+	value := a.Value
+	if minor, ok := currencyMinorUnits[a.Currency]; ok {
+		value = value.rescale(minor)
+	}
+	number := value.String()
+	sign := ""
+	if strings.HasPrefix(number, "-") {
+		sign, number = "-", number[1:]
+	}
+	whole, fraction, _ := strings.Cut(number, ".")
+	group := format.group
+	if group == " " {
+		group = moneySpace
+	}
+	result := sign + groupMoneyDigits(whole, group)
+	if len(fraction) > 0 {
+		result += format.decimal + fraction
+	}
+	return result
+}
+
+func (a Amount) String() string {
+	if len(a.Currency) == 0 {
+		return a.Value.String()
+	}
+	return a.Value.String() + " " + a.Currency
+}
+
+func (a Amount) IsZero() bool {
+	return a.Value.IsZero()
+}
+
+func getMoneyLocale(locale string) moneyLocale {
+	locale = strings.ReplaceAll(strings.TrimSpace(locale), "_", "-")
+	for name, format := range moneyLocales {
+		if strings.EqualFold(name, locale) {
+			return format
+		}
+	}
+	language, _, _ := strings.Cut(strings.ToLower(locale), "-")
+	if name, ok := moneyLanguages[language]; ok {
+		return moneyLocales[name]
+	}
+	return moneyLocales[defaultMoneyLocale]
+}
+
+func cutCurrency(value string) (string, string) {
+	for symbol, code := range currencySymbols {
+		if rest, ok := strings.CutPrefix(value, symbol); ok {
+			return code, trimMoneySpace(rest)
+		}
+		if rest, ok := strings.CutSuffix(value, symbol); ok {
+			return code, trimMoneySpace(rest)
+		}
+	}
+	if len(value) > 3 && isCurrencyCode(value[:3]) && !isLetter(value[3]) {
+		return strings.ToUpper(value[:3]), trimMoneySpace(value[3:])
+	}
+	if len(value) > 3 && isCurrencyCode(value[len(value)-3:]) && !isLetter(value[len(value)-4]) {
+		return strings.ToUpper(value[len(value)-3:]), trimMoneySpace(value[:len(value)-3])
+	}
+	return "", value
+}
+
+func normalizeMoneyNumber(value string, format moneyLocale) (string, error) {
+	sign := ""
+	if strings.HasPrefix(value, "-") || strings.HasPrefix(value, "+") {
+		sign, value = value[:1], trimMoneySpace(value[1:])
+	}
+	whole, fraction, hasFraction := strings.Cut(value, format.decimal)
+	if format.group == " " {
+		for _, space := range moneySpaces {
+			whole = strings.ReplaceAll(whole, space, format.group)
+		}
+	}
+	groups := strings.Split(whole, format.group)
+	for i, group := range groups {
+		if !isDigits(group) || len(group) == 0 && len(groups) > 1 {
+			return "", errInvalidAmount
+		}
+		if i == 0 && len(group) > 3 && len(groups) > 1 || i > 0 && len(group) != 3 {
+			return "", errInvalidAmount
+		}
+	}
+	number := sign + strings.Join(groups, "")
+	if hasFraction {
+		number += "." + fraction
+	}
+	return number, nil
+}
+
+func groupMoneyDigits(digits, group string) string {
+	if len(digits) <= 3 {
+		return digits
+	}
+	result := new(strings.Builder)
+	for i, r := range digits {
+		if i > 0 && (len(digits)-i)%3 == 0 {
+			result.WriteString(group)
+		}
+		result.WriteRune(r)
+	}
+	return result.String()
+}
+
+func parseMoneyValue(fb *FieldBuilder, values, currencies []string, locale string) (any, error) {
+	var err error
+	defaultCurrency := getDefaultCurrency(fb.value)
+	amounts := make([]Amount, len(values))
+	for i, value := range values {
+		currency := ""
+		if i < len(currencies) {
+			currency = currencies[i]
+		}
+		if len(currency) == 0 && len(currencies) > 0 {
+			currency = currencies[0]
+		}
+		amount := Amount{Currency: strings.ToUpper(currency)}
+		if len(strings.TrimSpace(value)) > 0 {
+			var parseErr error
+			amount, parseErr = ParseAmount(value, currency, locale)
+			if parseErr != nil && err == nil {
+				err = parseErr
+			}
+		}
+		if len(amount.Currency) == 0 {
+			amount.Currency = defaultCurrency
+		}
+		if len(amount.Currency) == 0 && len(strings.TrimSpace(value)) > 0 && err == nil {
+			err = errInvalidCurrency
+		}
+		amounts[i] = amount
+	}
+	if fb.multiple {
+		return amounts, err
+	}
+	return amounts[0], err
+}
+
+func getDefaultCurrency(value any) string {
+	switch v := value.(type) {
+	case Amount:
+		return v.Currency
+	case []Amount:
+		if len(v) > 0 {
+			return v[0].Currency
+		}
+	}
+	return ""
+}
+
+func isCurrencyCode(value string) bool {
+	_, ok := currencyMinorUnits[strings.ToUpper(value)]
+	return ok
+}
+
+func isLetter(b byte) bool {
+	return b < 0x80 && unicode.IsLetter(rune(b))
+}
+
+func trimMoneySpace(value string) string {
+	return strings.TrimFunc(value, unicode.IsSpace)
+}
@@ -0,0 +1,148 @@
+package form
+
+import (
+	"net/url"
+	"regexp"
+	"testing"
+	
+	"github.com/creamsensation/gox"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMoney(t *testing.T) {
+	t.Run(
+		"parse locale", func(t *testing.T) {
+			for _, item := range []struct {
+				value    string
+				currency string
+				locale   string
+				expected string
+			}{
+				{"1 234,50", "CZK", "cs-CZ", "1234.50 CZK"},
+				{"1\u00a0234,50 Kč", "", "cs-CZ", "1234.50 CZK"},
+				{"1,234.50", "USD", "en-US", "1234.50 USD"},
+				{"$1,234.5", "", "en-US", "1234.5 USD"},
+				{"EUR -1.234,05", "", "de", "-1234.05 EUR"},
+				{"0,99", "eur", "sk_SK", "0.99 EUR"},
+				{"12", "", "", "12"},
+			} {
+				amount, err := ParseAmount(item.value, item.currency, item.locale)
+				assert.Nil(t, err)
+				assert.Equal(t, item.expected, amount.String())
+			}
+		},
+	)
+	t.Run(
+		"parse invalid", func(t *testing.T) {
+			_, err := ParseAmount("1,5", "USD", "en-US")
+			assert.ErrorIs(t, err, errInvalidAmount)
+			_, err = ParseAmount("1.234,50", "CZK", "cs-CZ")
+			assert.ErrorIs(t, err, errInvalidAmount)
+			_, err = ParseAmount("10 EUR", "CZK", "cs-CZ")
+			assert.ErrorIs(t, err, errInvalidCurrency)
+			_, err = ParseAmount("10", "XYZ", "cs-CZ")
+			assert.ErrorIs(t, err, errInvalidCurrency)
+		},
+	)
+	t.Run(
+		"format", func(t *testing.T) {
+			amount := NewAmount(MustParseDecimal("1234567.5"), "czk")
+			assert.Equal(t, "1\u00a0234\u00a0567,50\u00a0CZK", amount.Format("cs-CZ"))
+			assert.Equal(t, "CZK\u00a01,234,567.50", amount.Format("en-US"))
+			assert.Equal(t, "-5\u00a0JPY", NewAmount(NewDecimal(-5, 0), "JPY").Format("cs"))
+			assert.Equal(t, "0.125", NewAmount(MustParseDecimal("0.125"), "").Format("en"))
+		},
+	)
+	t.Run(
+		"build", func(t *testing.T) {
+			req := testCreateValuesRequest(
+				url.Values{
+					"price":          {"1 234,50"},
+					"price.currency": {"EUR"},
+					"discount":       {"10.5"},
+				},
+			)
+			form, err := Build[testMoneyForm](
+				New(
+					Add("price").With(
+						Money(NewAmount(Decimal{}, "CZK")), Validate.Required(), Validate.Positive(), Validate.Decimals(),
+						Validate.Currencies("czk", "eur"),
+					),
+					Add("discount").With(Number[Decimal](), Validate.Decimals(1)),
+				).Locale("cs-CZ").Request(req),
+			)
+			assert.Nil(t, err)
+			assert.True(t, form.Valid)
+			assert.Equal(t, "cs-CZ", form.Locale)
+			assert.Equal(t, "1234.50 EUR", form.Price.Value.String())
+			assert.Equal(t, "1\u00a0234,50\u00a0EUR", form.Price.Value.Format(form.Locale))
+			assert.Equal(t, fieldDataTypeMoney, form.Price.DataType)
+		},
+	)
+	t.Run(
+		"render round trip", func(t *testing.T) {
+			amount := NewAmount(MustParseDecimal("1234567.5"), "EUR")
+			form, err := Build[testMoneyForm](New(Add("price").With(Money(amount))).Locale("cs-CZ").Request(testGetRequest()))
+			assert.Nil(t, err)
+			control := gox.Render(ControlNode(form.Form, form.Price))
+			assert.Contains(t, control, "name=\"price\" value=\"1\u00a0234\u00a0567,50\" inputmode=\"decimal\" />")
+			assert.Contains(t, control, `<input type="hidden" name="price.currency" value="EUR" />`)
+			values := url.Values{}
+			for _, match := range regexp.MustCompile(`name="([^"]+)" value="([^"]*)"`).FindAllStringSubmatch(control, -1) {
+				values.Add(match[1], match[2])
+			}
+			submitted, err := Build[testMoneyForm](
+				New(Add("price").With(Money())).Locale("cs-CZ").Request(testCreateValuesRequest(values)),
+			)
+			assert.Nil(t, err)
+			assert.True(t, submitted.Valid)
+			assert.Equal(t, "1234567.50 EUR", submitted.Price.Value.String())
+		},
+	)
+	t.Run(
+		"default currency", func(t *testing.T) {
+			req := testCreateValuesRequest(url.Values{"price": {"100"}})
+			form, err := Build[testMoneyForm](
+				New(Add("price").With(Money(NewAmount(Decimal{}, "CZK")))).Locale("cs-CZ").Request(req),
+			)
+			assert.Nil(t, err)
+			assert.Equal(t, "100 CZK", form.Price.Value.String())
+		},
+	)
+	t.Run(
+		"validators", func(t *testing.T) {
+			req := testCreateValuesRequest(
+				url.Values{
+					"price":          {"-0,001"},
+					"price.currency": {"USD"},
+					"discount":       {"0"},
+				},
+			)
+			form, err := Build[testMoneyForm](
+				New(
+					Add("price").With(
+						Money(), Validate.Positive(), Validate.Decimals(), Validate.Currencies("CZK"),
+					),
+					Add("discount").With(Number[Decimal](), Validate.Required(), Validate.Positive()),
+				).Locale("cs-CZ").Request(req),
+			)
+			assert.Nil(t, err)
+			assert.False(t, form.Valid)
+			assert.Equal(
+				t,
+				[]string{defaultPositiveMessage, defaultDecimalsMessage, defaultCurrencyMessage},
+				form.Price.Messages,
+			)
+			assert.Equal(t, []string{defaultPositiveMessage}, form.Discount.Messages)
+		},
+	)
+	t.Run(
+		"missing currency", func(t *testing.T) {
+			req := testCreateValuesRequest(url.Values{"price": {"100"}})
+			form, err := Build[testMoneyForm](New(Add("price").With(Money())).Request(req))
+			assert.Nil(t, err)
+			assert.False(t, form.Valid)
+			assert.Equal(t, []string{defaultInvalidMessage}, form.Price.Messages)
+		},
+	)
+}
@@ -1,9 +1,79 @@
 package form
 
+import (
+	"bytes"
+	"image"
+	_ "image/gif"
+	_ "image/jpeg"
+	_ "image/png"
+	"io"
+	"os"
+	"strings"
+)
+
 type Multipart struct {
-	Key    string `json:"key"`
-	Name   string `json:"name"`
-	Type   string `json:"type"`
-	Suffix string `json:"suffix"`
-	Data   []byte `json:"data"`
+	Key          string `json:"key"`
+	Name         string `json:"name"`
+	OriginalName string `json:"originalName"`
+	Type         string `json:"type"`
+	Suffix       string `json:"suffix"`
+	Path         string `json:"path"`
+	Hash         string `json:"hash"`
+	StorageKey   string `json:"storageKey"`
+	Url          string `json:"url"`
+	Stash        string `json:"stash"`
+	Width        int    `json:"width"`
+	Height       int    `json:"height"`
+	Size         int64  `json:"size"`
+	Data         []byte `json:"data"`
+	temp         bool
+}
+
+func (m Multipart) Open() (io.ReadCloser, error) {
+	if len(m.Path) > 0 {
+		return os.Open(m.Path)
+	}
+	return io.NopCloser(bytes.NewReader(m.Data)), nil
+}
+
+func (m Multipart) Remove() error {
+	if len(m.Path) == 0 {
+		return nil
+	}
+	return os.Remove(m.Path)
+}
+
+func (m *Multipart) readImageMetadata() {
+	if !strings.HasPrefix(m.Type, "image/") || m.isEmpty() {
+		return
+	}
+	reader, err := m.Open()
+	if err != nil {
+		return
+	}
+	defer reader.Close()
+	config, _, err := image.DecodeConfig(reader)
+	if err != nil {
+		return
+	}
+	m.Width = config.Width
+	m.Height = config.Height
+}
+
+func (m Multipart) Stored() string {
+	if len(m.Url) > 0 {
+		return m.Url
+	}
+	return m.StorageKey
+}
+
+func (m Multipart) size() int64 {
+	if m.Size > 0 {
+		return m.Size
+	}
+	return int64(len(m.Data))
+}
+
+func (m Multipart) isEmpty() bool {
+	return len(m.Data) == 0 && m.Size == 0
 }
@@ -0,0 +1,148 @@
+package form
+
+import (
+	"fmt"
+	"math"
+	"math/big"
+	"reflect"
+	"strconv"
+)
+
+var (
+	decimalType = reflect.TypeOf(Decimal{})
+)
+
+func getNumberDataType(value any) string {
+	t := reflect.TypeOf(value)
+	if t == nil {
+		return ""
+	}
+	if t == decimalType {
+		return fieldDataTypeDecimal
+	}
+	switch t.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return fieldDataTypeInt
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return fieldDataTypeUint
+	case reflect.Float32, reflect.Float64:
+		return fieldDataTypeFloat
+	}
+	return ""
+}
+
+func parseNumberValue(fb *FieldBuilder, values []string) (any, error) {
+	t := reflect.TypeOf(fb.value)
+	if !fb.multiple {
+		n, err := parseNumber(t, values[0])
+		return n.Interface(), err
+	}
+	var err error
+	result := reflect.MakeSlice(t, len(values), len(values))
+	for i, v := range values {
+		n, parseErr := parseNumber(t.Elem(), v)
+		if parseErr != nil && err == nil {
+			err = parseErr
+		}
+		result.Index(i).Set(n)
+	}
+	return result.Interface(), err
+}
+
+func parseNumber(t reflect.Type, value string) (reflect.Value, error) {
+	result := reflect.New(t).Elem()
+	if len(value) == 0 {
+		return result, nil
+	}
+	if t == decimalType {
+		d, err := ParseDecimal(value)
+		if err != nil {
+			return result, err
+		}
+		result.Set(reflect.ValueOf(d))
+		return result, nil
+	}
+	switch t.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := strconv.ParseInt(value, 10, t.Bits())
+		if err != nil {
+			return result, err
+		}
+		result.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		n, err := strconv.ParseUint(value, 10, t.Bits())
+		if err != nil {
+			return result, err
+		}
+		result.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		n, err := strconv.ParseFloat(value, t.Bits())
+		if err != nil {
+			return result, err
+		}
+		if math.IsNaN(n) || math.IsInf(n, 0) {
+			return result, fmt.Errorf("invalid number %s", value)
+		}
+		result.SetFloat(n)
+	}
+	return result, nil
+}
+
+func convertToRat(value any) (*big.Rat, bool) {
+	switch v := value.(type) {
+	case Decimal:
+		return v.Rat(), true
+	case Amount:
+		return v.Value.Rat(), true
+	}
+	v := reflect.ValueOf(value)
+	switch v.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return new(big.Rat).SetInt64(v.Int()), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return new(big.Rat).SetFrac(new(big.Int).SetUint64(v.Uint()), big.NewInt(1)), true
+	case reflect.Float32, reflect.Float64:
+		r := new(big.Rat).SetFloat64(v.Float())
+		return r, r != nil
+	}
+	return nil, false
+}
+
+func compareNumber(value, bound any) (int, bool) {
+	v, ok := convertToRat(value)
+	if !ok {
+		return 0, false
+	}
+	b, ok := convertToRat(bound)
+	if !ok {
+		return 0, false
+	}
+	return v.Cmp(b), true
+}
+
+func convertBoundToInt(bound any) int {
+	r, ok := convertToRat(bound)
+	if !ok {
+		return 0
+	}
+	n := new(big.Int).Quo(r.Num(), r.Denom())
+	if !n.IsInt64() {
+		if n.Sign() < 0 {
+			return math.MinInt
+		}
+		return math.MaxInt
+	}
+	return int(n.Int64())
+}
+
+func getValueItems(value any) []any {
+	v := reflect.ValueOf(value)
+	if v.Kind() != reflect.Slice {
+		return []any{value}
+	}
+	result := make([]any, v.Len())
+	for i := range result {
+		result[i] = v.Index(i).Interface()
+	}
+	return result
+}
@@ -0,0 +1,113 @@
+package form
+
+import (
+	"math"
+	"net/url"
+	"testing"
+	
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNumber(t *testing.T) {
+	t.Run(
+		"parse kinds", func(t *testing.T) {
+			req := testCreateValuesRequest(
+				url.Values{
+					"count":    {"9223372036854775807"},
+					"small":    {"-128"},
+					"unsigned": {"42"},
+					"ratio":    {"1.5"},
+					"price":    {"999.90"},
+					"counts":   {"1", "2"},
+				},
+			)
+			form, err := Build[testNumberForm](testCreateNumberBuilder().Request(req))
+			assert.Nil(t, err)
+			assert.True(t, form.Valid)
+			assert.Equal(t, int64(math.MaxInt64), form.Count.Value)
+			assert.Equal(t, int8(-128), form.Small.Value)
+			assert.Equal(t, uint(42), form.Unsigned.Value)
+			assert.Equal(t, float32(1.5), form.Ratio.Value)
+			assert.Equal(t, "999.90", form.Price.Value.String())
+			assert.Equal(t, []int64{1, 2}, form.Counts.Value)
+			assert.Equal(t, fieldDataTypeDecimal, form.Price.DataType)
+			assert.Equal(t, fieldDataTypeUint, form.Unsigned.DataType)
+		},
+	)
+	t.Run(
+		"overflow", func(t *testing.T) {
+			req := testCreateValuesRequest(
+				url.Values{
+					"count":    {"9223372036854775808"},
+					"small":    {"128"},
+					"unsigned": {"-1"},
+					"ratio":    {"1e39"},
+					"price":    {"1.2.3"},
+				},
+			)
+			form, err := Build[testNumberForm](testCreateNumberBuilder().Request(req))
+			assert.Nil(t, err)
+			assert.False(t, form.Valid)
+			assert.Equal(t, []string{defaultInvalidMessage}, form.Count.Messages)
+			assert.Equal(t, []string{defaultInvalidMessage}, form.Small.Messages)
+			assert.Equal(t, []string{defaultInvalidMessage}, form.Unsigned.Messages)
+			assert.Equal(t, []string{defaultInvalidMessage}, form.Ratio.Messages)
+			assert.Equal(t, []string{defaultInvalidMessage}, form.Price.Messages)
+		},
+	)
+	t.Run(
+		"bounds", func(t *testing.T) {
+			req := testCreateValuesRequest(url.Values{"price": {"1000.01"}, "ratio": {"0.5"}})
+			form, err := Build[testNumberForm](
+				New(
+					Add("price").With(Number[Decimal](), Validate.Max(int64(1000))),
+					Add("ratio").With(Number[float32](), Validate.Min(0.75)),
+				).Request(req),
+			)
+			assert.Nil(t, err)
+			assert.Equal(t, []string{defaultMaxNumberMessage}, form.Price.Messages)
+			assert.Equal(t, []string{defaultMinNumberMessage}, form.Ratio.Messages)
+		},
+	)
+	t.Run(
+		"invalid bound", func(t *testing.T) {
+			assert.Panics(
+				t, func() {
+					Validate.Min("10")
+				},
+			)
+			assert.Panics(
+				t, func() {
+					Validate.Max(math.NaN())
+				},
+			)
+			assert.Panics(
+				t, func() {
+					Validate.Max(math.Inf(1))
+				},
+			)
+		},
+	)
+	t.Run(
+		"type mismatch", func(t *testing.T) {
+			assert.Panics(
+				t, func() {
+					_, _ = Build[testNumberForm](New(Add("count").With(Number[int]())))
+				},
+			)
+		},
+	)
+	t.Run(
+		"default value", func(t *testing.T) {
+			form, err := Build[testNumberForm](
+				New(
+					Add("count").With(Number[int64](7)),
+					Add("price").With(Number(MustParseDecimal("12.50"))),
+				),
+			)
+			assert.Nil(t, err)
+			assert.Equal(t, int64(7), form.Count.Value)
+			assert.Equal(t, "12.50", form.Price.Value.String())
+		},
+	)
+}
@@ -1,11 +1,14 @@
 package form
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
 	"fmt"
 	"io"
 	"mime/multipart"
 	"net/http"
 	"net/url"
+	"slices"
 	"time"
 )
 
@@ -26,55 +29,80 @@ func processRequest(req *http.Request, limit int) (url.Values, map[string][]*mul
 }
 
 func processFormData(form *Builder, data url.Values) {
-	for i, field := range form.fields {
+	processFieldsData(form.fields, data, form.locale)
+}
+
+func processFieldsData(fields []*FieldBuilder, data url.Values, locale string) {
+	for i, field := range fields {
 		switch field.dataType {
 		case fieldDataTypeBool:
 			if !field.multiple {
-				form.fields[i].value = data.Get(field.name) == "on"
+				fields[i].value = slices.Contains(data[field.name], field.getCheckValue())
+				fields[i].present = fields[i].value.(bool)
+			}
+			if field.multiple {
+				value, invalid := parseCheckedIndexes(len(field.value.([]bool)), data[field.name])
+				fields[i].value = value
+				fields[i].invalid = invalid
+				fields[i].present = slices.Contains(value, true)
+			}
+			continue
+		case fieldDataTypeGroup:
+			for index, values := range parseGroupData(field, data) {
+				processFieldsData(field.group.item(index).fields, values, locale)
 			}
 			continue
 		}
+		if len(field.options) > 0 {
+			processOptions(fields[i], transformValues(field, data[field.name]))
+			continue
+		}
 		for name, item := range data {
 			if len(item) == 0 || name != field.name {
 				continue
 			}
+			item = transformValues(field, item)
+			if field.policy != nil {
+				item = sanitizeFieldValue(item, field.policy).([]string)
+			}
+			if field.dataType != fieldDataTypeFile {
+				fields[i].present = isValuePresent(field.dataType, item)
+			}
 			switch field.dataType {
 			case fieldDataTypeString:
 				if !field.multiple {
-					form.fields[i].value = item[0]
+					fields[i].value = item[0]
 				}
 				if field.multiple {
-					form.fields[i].value = item
+					fields[i].value = item
 				}
-			case fieldDataTypeFloat:
-				if !field.multiple {
-					form.fields[i].value = convertToFloat(item[0])
-				}
-				if field.multiple {
-					form.fields[i].value = convertSlice[string, float64](
-						item, func(v string) float64 {
-							return convertToFloat(v)
-						},
-					)
-				}
-			case fieldDataTypeInt:
-				if !field.multiple {
-					form.fields[i].value = convertToInt(item[0])
-				}
-				if field.multiple {
-					form.fields[i].value = convertSlice[string, int](
-						item, func(v string) int {
-							return convertToInt(v)
-						},
-					)
+			case fieldDataTypeInt, fieldDataTypeUint, fieldDataTypeFloat, fieldDataTypeDecimal:
+				value, err := parseNumberValue(field, item)
+				fields[i].value = value
+				fields[i].invalid = err != nil
+				fields[i].present = fields[i].present && err == nil
+			case fieldDataTypeCustom:
+				value, err := parseCustomValue(field, item)
+				fields[i].value = value
+				fields[i].invalid = err != nil
+				fields[i].present = fields[i].present && err == nil
+			case fieldDataTypeMoney:
+				value, err := parseMoneyValue(field, item, data[field.name+moneyCurrencySuffix], locale)
+				fields[i].value = value
+				fields[i].invalid = err != nil
+				fields[i].present = fields[i].present && err == nil
+			case fieldDataTypeFile:
+				if field.uploads != nil || field.stash != nil {
+					fields[i].value = processFileReferences(field, item)
+					fields[i].present = isFileValuePresent(fields[i].value)
 				}
 			case fieldDataTypeTime:
 				if !field.multiple {
 					t, _ := time.Parse(fieldTimeFormat, item[0])
-					form.fields[i].value = t
+					fields[i].value = t
 				}
 				if field.multiple {
-					form.fields[i].value = convertSlice[string, time.Time](
+					fields[i].value = convertSlice[string, time.Time](
 						item, func(v string) time.Time {
 							t, _ := time.Parse(fieldTimeFormat, v)
 							return t
@@ -86,8 +114,34 @@ func processFormData(form *Builder, data url.Values) {
 	}
 }
 
+func processFileReferences(field *FieldBuilder, references []string) any {
+	files := make([]Multipart, 0)
+	for _, reference := range references {
+		if field.stash != nil {
+			if file, err := field.stash.restore(reference); err == nil {
+				file.Key = field.name
+				files = append(files, file)
+				continue
+			}
+		}
+		if field.uploads != nil {
+			if file, err := field.uploads.Multipart(reference); err == nil {
+				file.Key = field.name
+				files = append(files, file)
+			}
+		}
+	}
+	if field.multiple {
+		return files
+	}
+	if len(files) == 0 {
+		return Multipart{}
+	}
+	return files[0]
+}
+
 func processFormFiles(form *Builder, multipartFiles map[string][]*multipart.FileHeader) error {
-	requestFiles := make([]Multipart, 0)
+	requestFiles := make(map[string][]Multipart)
 	for key, files := range multipartFiles {
 		for _, file := range files {
 			f, err := file.Open()
@@ -95,34 +149,64 @@ func processFormFiles(form *Builder, multipartFiles map[string][]*multipart.File
 				return fmt.Errorf("error while opening multipart file: %w", err)
 			}
 			data, err := io.ReadAll(f)
+			_ = f.Close()
 			if err != nil {
 				return fmt.Errorf("error while reading multipart file: %w", err)
 			}
-			requestFiles = append(
-				requestFiles, Multipart{
-					Key:    key,
-					Name:   file.Filename,
-					Type:   http.DetectContentType(data),
-					Suffix: getFileSuffixFromName(file.Filename),
-					Data:   data,
-				},
-			)
+			hash := sha256.Sum256(data)
+			name := sanitizeFileName(file.Filename)
+			fileType := http.DetectContentType(data)
+			requestFile := Multipart{
+				Key:          key,
+				Name:         name,
+				OriginalName: file.Filename,
+				Type:         fileType,
+				Suffix:       getFileSuffix(name, fileType),
+				Hash:         hex.EncodeToString(hash[:]),
+				Size:         int64(len(data)),
+				Data:         data,
+			}
+			requestFile.readImageMetadata()
+			requestFiles[key] = append(requestFiles[key], requestFile)
 		}
 	}
-	for i, field := range form.fields {
-		for _, file := range requestFiles {
-			if file.Key != field.name {
-				continue
+	processFieldsFiles(form.fields, requestFiles)
+	return nil
+}
+
+func processFieldsFiles(fields []*FieldBuilder, files map[string][]Multipart) {
+	for i, field := range fields {
+		if field.dataType == fieldDataTypeGroup {
+			for index, items := range parseGroupFiles(field, files) {
+				processFieldsFiles(field.group.item(index).fields, items)
 			}
+			continue
+		}
+		for _, file := range files[field.name] {
 			if !field.multiple {
-				form.fields[i].value = file
+				fields[i].value = file
 			}
 			if field.multiple {
-				form.fields[i].value = append(form.fields[i].value.([]Multipart), file)
+				fields[i].value = append(fields[i].value.([]Multipart), file)
 			}
 		}
+		if len(files[field.name]) > 0 {
+			fields[i].present = isFileValuePresent(fields[i].value)
+		}
+	}
+}
+
+func resetFieldsPresent(fields []*FieldBuilder) {
+	for _, field := range fields {
+		field.present = false
+		if field.group == nil {
+			continue
+		}
+		resetFieldsPresent(field.group.fields)
+		for _, item := range field.group.items {
+			resetFieldsPresent(item.fields)
+		}
 	}
-	return nil
 }
 
 func parseForm(req *http.Request, limit int) (int, error) {
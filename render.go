@@ -0,0 +1,119 @@
+package form
+
+import (
+	"errors"
+	"fmt"
+	
+	"github.com/creamsensation/gox"
+)
+
+type formLayout struct {
+	sections []layoutSection
+	nodes    map[string][]gox.Node
+	submit   string
+}
+
+type layoutSection struct {
+	row    bool
+	legend string
+	names  []string
+}
+
+const (
+	defaultSubmitLabel = "Submit"
+)
+
+var (
+	errFormNotBuilt = errors.New("form is not built")
+)
+
+func (b *Builder) Fieldset(legend string, names ...string) *Builder {
+	b.layout.sections = append(b.layout.sections, layoutSection{legend: legend, names: names})
+	return b
+}
+
+func (b *Builder) Row(names ...string) *Builder {
+	b.layout.sections = append(b.layout.sections, layoutSection{row: true, names: names})
+	return b
+}
+
+func (b *Builder) Insert(before string, nodes ...gox.Node) *Builder {
+	if b.layout.nodes == nil {
+		b.layout.nodes = make(map[string][]gox.Node)
+	}
+	b.layout.nodes[before] = append(b.layout.nodes[before], nodes...)
+	return b
+}
+
+func (b *Builder) Submit(label string) *Builder {
+	b.layout.submit = label
+	return b
+}
+
+func (b *Builder) Node(nodes ...gox.Node) (gox.Node, error) {
+	if !b.built {
+		return nil, fmt.Errorf("error while rendering form: %w", errFormNotBuilt)
+	}
+	return createBaseForm(b).Render(nodes...), nil
+}
+
+func (f Form) Render(nodes ...gox.Node) gox.Node {
+	renderer := f.getRenderer()
+	result := []gox.Node{renderer.Summary(f)}
+	rendered := make(map[string]bool)
+	layout := f.getLayout()
+	for _, fb := range f.getFields() {
+		if rendered[fb.name] {
+			continue
+		}
+		section, ok := layout.findSection(fb.name)
+		if !ok {
+			rendered[fb.name] = true
+			result = append(result, f.renderLayoutField(renderer, fb))
+			continue
+		}
+		items := make([]gox.Node, 0, len(section.names))
+		for _, name := range section.names {
+			field := findField(f.getFields(), name)
+			if field == nil || rendered[name] {
+				continue
+			}
+			rendered[name] = true
+			items = append(items, f.renderLayoutField(renderer, field))
+		}
+		if section.row {
+			result = append(result, renderer.Row(items...))
+			continue
+		}
+		result = append(result, renderer.Fieldset(section.legend, items...))
+	}
+	result = append(result, layout.nodes[""]...)
+	result = append(result, nodes...)
+	result = append(result, renderer.Submit(layout.getSubmitLabel()))
+	return renderer.Render(f, result...)
+}
+
+func (f Form) renderLayoutField(renderer Renderer, fb *FieldBuilder) gox.Node {
+	return gox.Fragment(
+		gox.Fragment(f.getLayout().nodes[fb.name]...),
+		renderField(f, renderer, fb),
+	)
+}
+
+func (l formLayout) findSection(name string) (layoutSection, bool) {
+	for _, section := range l.sections {
+		for _, n := range section.names {
+			if n == name {
+				return section, true
+			}
+		}
+	}
+	return layoutSection{}, false
+}
+
+func (l formLayout) getSubmitLabel() string {
+	if len(l.submit) > 0 {
+		return l.submit
+	}
+	return defaultSubmitLabel
+}
@@ -0,0 +1,94 @@
+package form
+
+import (
+	"net/url"
+	"strings"
+	"testing"
+	
+	"github.com/creamsensation/gox"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestRender(t *testing.T) {
+	t.Run(
+		"builder node", func(t *testing.T) {
+			b := New(
+				Add("name").With(Text()).Label("Name"),
+				Add("checked").With(Checkbox()).Label("Checked"),
+			).Method("POST").Action("/save").Csrf("csrf", "token").Request(testGetRequest())
+			_, err := b.Node()
+			assert.ErrorIs(t, err, errFormNotBuilt)
+			_, err = Build[testForm](b)
+			assert.Nil(t, err)
+			node, err := b.Node()
+			assert.Nil(t, err)
+			result := gox.Render(node)
+			assert.Equal(
+				t,
+				`<form method="POST" action="/save" enctype="application/x-www-form-urlencoded">`+
+					`<input type="hidden" name="__csrf_name__" value="csrf" /><input type="hidden" name="__csrf_token__" value="token" />`+
+					`<div><label for="name">Name</label><input type="text" id="name" name="name" /></div>`+
+					`<div><div><input type="checkbox" id="checked" name="checked" value="on" /><label for="checked">Checked</label></div></div>`+
+					`<button type="submit">Submit</button>`+
+					`</form>`,
+				result,
+			)
+		},
+	)
+	t.Run(
+		"errors", func(t *testing.T) {
+			b := New(Add("name").With(Text(), Validate.Required()).Label("Name")).Request(testCreateValuesRequest(url.Values{}))
+			_, err := Build[testForm](b)
+			assert.Nil(t, err)
+			node, err := b.Node()
+			assert.Nil(t, err)
+			result := gox.Render(node)
+			assert.Contains(t, result, `<div role="alert"><ul><li><a href="#name">Name: field is required</a></li></ul></div>`)
+			assert.Contains(t, result, `autofocus="autofocus"`)
+			assert.Contains(t, result, `<div id="name-error"><p>field is required</p></div>`)
+		},
+	)
+	t.Run(
+		"layout", func(t *testing.T) {
+			form, err := Build[testForm](
+				New(
+					Add("email").With(Email()),
+					Add("name").With(Text()).Label("Name"),
+					Add("quantity").With(Number[int]()).Label("Quantity"),
+					Add("amount").With(Number[float64]()),
+				).
+					Fieldset("Contact", "name", "email").
+					Row("quantity", "amount").
+					Insert("quantity", gox.P(gox.Text("Order"))).
+					Insert("", gox.P(gox.Text("End"))).
+					Submit("Save").
+					Theme(ThemeBootstrap).
+					Request(testGetRequest()),
+			)
+			assert.Nil(t, err)
+			result := gox.Render(form.Render(gox.P(gox.Text("Note"))))
+			assert.Contains(t, result, `<fieldset class="mb-3"><legend class="form-label">Contact</legend><div class="mb-3"><label for="name"`)
+			assert.Contains(t, result, `id="email"`)
+			assert.Less(t, strings.Index(result, `id="name"`), strings.Index(result, `id="email"`))
+			assert.Contains(t, result, `<div class="row"><div class="col"><p>Order</p><div class="mb-3"><label for="quantity"`)
+			assert.Contains(t, result, `<p>End</p><p>Note</p><button type="submit" class="btn btn-primary">Save</button></form>`)
+		},
+	)
+	t.Run(
+		"groups", func(t *testing.T) {
+			b := New(
+				Add("address").With(Group(Add("street").With(Text()))).Label("Address"),
+			).Request(testGetRequest())
+			_, err := Build[testInvoiceForm](b)
+			assert.Nil(t, err)
+			node, err := b.Node()
+			assert.Nil(t, err)
+			result := gox.Render(node)
+			assert.Contains(
+				t,
+				result,
+				`<fieldset id="address"><legend>Address</legend><div><input type="text" id="address-street" name="address.street" /></div></fieldset>`,
+			)
+		},
+	)
+}
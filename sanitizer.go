@@ -0,0 +1,188 @@
+package form
+
+import (
+	"io"
+	"slices"
+	"strings"
+	
+	"golang.org/x/net/html"
+)
+
+type SanitizePolicy struct {
+	Tags       []string
+	Attributes map[string][]string
+	Schemes    []string
+}
+
+const (
+	sanitizeAnyTag = "*"
+)
+
+var (
+	defaultSanitizePolicy = SanitizePolicy{
+		Tags: []string{
+			"a", "b", "blockquote", "br", "code", "del", "em", "h1", "h2", "h3", "h4", "h5", "h6", "hr", "i", "img",
+			"li", "ol", "p", "pre", "s", "span", "strong", "sub", "sup", "table", "tbody", "td", "th", "thead", "tr",
+			"u", "ul",
+		},
+		Attributes: map[string][]string{
+			"a":   {"href", "title"},
+			"img": {"src", "alt", "title", "width", "height"},
+			"td":  {"colspan", "rowspan"},
+			"th":  {"colspan", "rowspan"},
+		},
+		Schemes: []string{"http", "https", "mailto"},
+	}
+	sanitizeDropContentTags = []string{
+		"script", "style", "iframe", "object", "embed", "noscript", "template", "textarea", "title", "svg", "math",
+		"frameset", "frame", "noembed", "xmp",
+	}
+	sanitizeVoidTags      = []string{"br", "hr", "img", "wbr"}
+	sanitizeUrlAttributes = []string{
+		"href", "src", "cite", "action", "formaction", "background", "poster", "longdesc", "xlink:href",
+	}
+)
+
+func RichText(value ...string) FieldConfig {
+	return FieldConfig{
+		fieldType: fieldTypeRichText,
+		dataType:  fieldDataTypeString,
+		value:     value,
+		policy:    DefaultSanitizePolicy(),
+	}
+}
+
+func DefaultSanitizePolicy() *SanitizePolicy {
+	return defaultSanitizePolicy.clone()
+}
+
+func (b *FieldBuilder) Sanitize(policy SanitizePolicy) *FieldBuilder {
+	b.policy = policy.clone()
+	b.value = sanitizeFieldValue(b.value, b.policy)
+	return b
+}
+
+func (p SanitizePolicy) clone() *SanitizePolicy {
+	attributes := make(map[string][]string, len(p.Attributes))
+	for tag, names := range p.Attributes {
+		attributes[tag] = slices.Clone(names)
+	}
+	return &SanitizePolicy{
+		Tags:       slices.Clone(p.Tags),
+		Attributes: attributes,
+		Schemes:    slices.Clone(p.Schemes),
+	}
+}
+
+func sanitizeFieldValue(value any, policy *SanitizePolicy) any {
+	switch v := value.(type) {
+	case string:
+		return sanitizeHtml(v, policy)
+	case []string:
+		return convertSlice[string, string](
+			v, func(item string) string {
+				return sanitizeHtml(item, policy)
+			},
+		)
+	}
+	return value
+}
+
+func sanitizeHtml(value string, policy *SanitizePolicy) string {
+	result := new(strings.Builder)
+	tokenizer := html.NewTokenizer(strings.NewReader(value))
+	open := make([]string, 0)
+	skip := make([]string, 0)
+	for {
+		tokenType := tokenizer.Next()
+		if tokenType == html.ErrorToken {
+			if tokenizer.Err() != io.EOF {
+				return ""
+			}
+			break
+		}
+		token := tokenizer.Token()
+		tag := strings.ToLower(token.Data)
+		if len(skip) > 0 {
+			switch {
+			case tokenType == html.StartTagToken && tag == skip[len(skip)-1]:
+				skip = append(skip, tag)
+			case tokenType == html.EndTagToken && tag == skip[len(skip)-1]:
+				skip = skip[:len(skip)-1]
+			}
+			continue
+		}
+		switch tokenType {
+		case html.TextToken:
+			result.WriteString(html.EscapeString(token.Data))
+		case html.StartTagToken, html.SelfClosingTagToken:
+			if slices.Contains(sanitizeDropContentTags, tag) {
+				if tokenType == html.StartTagToken {
+					skip = append(skip, tag)
+				}
+				continue
+			}
+			if !slices.Contains(policy.Tags, tag) {
+				continue
+			}
+			result.WriteString("<" + tag)
+			for _, attribute := range token.Attr {
+				name := strings.ToLower(attribute.Key)
+				if !isSanitizeAttributeAllowed(policy, tag, name, attribute.Val) {
+					continue
+				}
+				result.WriteString(" " + name + `="` + html.EscapeString(attribute.Val) + `"`)
+			}
+			result.WriteString(">")
+			if !slices.Contains(sanitizeVoidTags, tag) {
+				open = append(open, tag)
+			}
+		case html.EndTagToken:
+			index := slices.Index(open, tag)
+			if index == -1 {
+				continue
+			}
+			for i := len(open) - 1; i >= index; i-- {
+				result.WriteString("</" + open[i] + ">")
+			}
+			open = open[:index]
+		}
+	}
+	for i := len(open) - 1; i >= 0; i-- {
+		result.WriteString("</" + open[i] + ">")
+	}
+	return result.String()
+}
+
+func isSanitizeAttributeAllowed(policy *SanitizePolicy, tag, name, value string) bool {
+	if strings.HasPrefix(name, "on") {
+		return false
+	}
+	if !slices.Contains(policy.Attributes[tag], name) && !slices.Contains(policy.Attributes[sanitizeAnyTag], name) {
+		return false
+	}
+	if slices.Contains(sanitizeUrlAttributes, name) {
+		return isSanitizeUrlAllowed(policy, value)
+	}
+	return true
+}
+
+func isSanitizeUrlAllowed(policy *SanitizePolicy, value string) bool {
+	normalized := strings.Map(
+		func(r rune) rune {
+			if r <= ' ' || r == 0x7f {
+				return -1
+			}
+			return r
+		},
+		value,
+	)
+	colon := strings.Index(normalized, ":")
+	if colon == -1 {
+		return true
+	}
+	if end := strings.IndexAny(normalized, "/?#"); end > -1 && end < colon {
+		return true
+	}
+	return slices.Contains(policy.Schemes, strings.ToLower(normalized[:colon]))
+}
@@ -0,0 +1,86 @@
+package form
+
+import (
+	"net/url"
+	"testing"
+	
+	"github.com/stretchr/testify/assert"
+)
+
+func TestSanitizer(t *testing.T) {
+	sanitize := func(value string) string {
+		return sanitizeHtml(value, DefaultSanitizePolicy())
+	}
+	t.Run(
+		"allowed markup", func(t *testing.T) {
+			assert.Equal(t, "<p>Hello <strong>world</strong></p>", sanitize("<p>Hello <strong>world</strong></p>"))
+			assert.Equal(t, `<a href="https://example.com" title="x">link</a>`, sanitize(`<A HREF="https://example.com" title="x" target="_blank">link</a>`))
+			assert.Equal(t, `<p>a<br>b</p>`, sanitize(`<p>a<br/>b`))
+			assert.Equal(t, `1 &lt; 2 &amp;&amp; 3 &gt; 2`, sanitize(`1 < 2 && 3 > 2`))
+		},
+	)
+	t.Run(
+		"scripts and handlers", func(t *testing.T) {
+			assert.Equal(t, "<p>ok</p>", sanitize(`<p>ok</p><script>alert(1)</script>`))
+			assert.Equal(t, "<p>ok</p>", sanitize(`<p onclick="alert(1)">ok</p>`))
+			assert.Equal(t, `<img src="x" alt="x">`, sanitize(`<img src=x onerror=alert(1) alt="x">`+"<style>p{}</style>"))
+			assert.Equal(t, "text", sanitize(`<div><iframe src="https://evil"><p>in</p></iframe>text</div>`))
+			assert.Equal(t, "", sanitize(`<svg><svg onload=alert(1)></svg></svg>`))
+			assert.Equal(t, "&lt;b&gt;", sanitize(`<!-- comment --><textarea><b></textarea>&lt;b&gt;`))
+		},
+	)
+	t.Run(
+		"urls", func(t *testing.T) {
+			assert.Equal(t, "<a>x</a>", sanitize(`<a href="javascript:alert(1)">x</a>`))
+			assert.Equal(t, "<a>x</a>", sanitize(`<a href="java&#x09;script:alert(1)">x</a>`))
+			assert.Equal(t, "<a>x</a>", sanitize(`<a href=" JaVaScRiPt:alert(1)">x</a>`))
+			assert.Equal(t, "<a>x</a>", sanitize(`<a href="data:text/html,x">x</a>`))
+			assert.Equal(t, `<a href="/page?a=b:c">x</a>`, sanitize(`<a href="/page?a=b:c">x</a>`))
+			assert.Equal(t, `<a href="mailto:test@test.cz">x</a>`, sanitize(`<a href="mailto:test@test.cz">x</a>`))
+		},
+	)
+	t.Run(
+		"unclosed and misnested tags", func(t *testing.T) {
+			assert.Equal(t, "<p><em>a</em></p>", sanitize("<p><em>a</p>"))
+			assert.Equal(t, "<ul><li>a</li></ul>", sanitize("<ul><li>a"))
+			assert.Equal(t, "a", sanitize("a</div></p>"))
+		},
+	)
+	t.Run(
+		"custom policy", func(t *testing.T) {
+			policy := SanitizePolicy{
+				Tags:       []string{"p", "a"},
+				Attributes: map[string][]string{"*": {"class"}, "a": {"href"}},
+				Schemes:    []string{"https"},
+			}
+			assert.Equal(t, `<p class="x">a</p><a>b</a>`, sanitizeHtml(`<p class="x">a</p><a href="http://x">b</a><b>`, &policy))
+		},
+	)
+	t.Run(
+		"default policy copy", func(t *testing.T) {
+			policy := DefaultSanitizePolicy()
+			policy.Tags = append(policy.Tags[:0], "div")
+			policy.Attributes["a"][0] = "onclick"
+			fb := Add("name").With(RichText(`<div><a href="/x" onclick="x">a</a></div>`))
+			assert.Equal(t, `<a href="/x">a</a>`, fb.value)
+			assert.NotSame(t, fb.policy, Add("name").With(RichText()).policy)
+		},
+	)
+	t.Run(
+		"rich text field", func(t *testing.T) {
+			req := testCreateValuesRequest(url.Values{"name": {`<p>Hi<script>x</script></p>`}, "email": {"<b>x</b>"}})
+			form, err := Build[testForm](
+				New(
+					Add("name").With(RichText(), Validate.Required()),
+					Add("email").With(Text()).Sanitize(SanitizePolicy{}),
+				).Request(req),
+			)
+			assert.Nil(t, err)
+			assert.Equal(t, "<p>Hi</p>", form.Name.Value)
+			assert.Equal(t, fieldTypeRichText, form.Name.Type)
+			assert.Equal(t, "x", form.Email.Value)
+			fb := Add("name").With(RichText(`<b onclick="x">a</b>`))
+			assert.Equal(t, "<b>a</b>", fb.value)
+		},
+	)
+}
@@ -0,0 +1,271 @@
+package form
+
+import (
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+	
+	"github.com/creamsensation/gox"
+)
+
+type FileStash struct {
+	dir    string
+	secret []byte
+	expiry time.Duration
+	now    func() time.Time
+}
+
+type stashReference struct {
+	Key          string `json:"k"`
+	Name         string `json:"n"`
+	OriginalName string `json:"o"`
+	Type         string `json:"t"`
+	Suffix       string `json:"s"`
+	Hash         string `json:"h"`
+	Size         int64  `json:"z"`
+	Width        int    `json:"w"`
+	Height       int    `json:"hg"`
+	Expires      int64  `json:"e"`
+}
+
+const (
+	stashKeyLength     = 16
+	defaultStashExpiry = time.Hour
+)
+
+var (
+	errInvalidStashReference = errors.New("invalid stash reference")
+)
+
+func NewFileStash(dir string, secret []byte, expiry ...time.Duration) *FileStash {
+	s := &FileStash{
+		dir:    dir,
+		secret: secret,
+		expiry: defaultStashExpiry,
+		now:    time.Now,
+	}
+	if len(expiry) > 0 {
+		s.expiry = expiry[0]
+	}
+	return s
+}
+
+func (b *FieldBuilder) Stash(stash *FileStash) *FieldBuilder {
+	b.stash = stash
+	return b
+}
+
+func StashNode[T Multipart | []Multipart](field Field[T]) gox.Node {
+	files := make([]Multipart, 0)
+	switch fv := any(field.Value).(type) {
+	case Multipart:
+		files = append(files, fv)
+	case []Multipart:
+		files = fv
+	}
+	nodes := make([]gox.Node, 0)
+	for _, file := range files {
+		if len(file.Stash) == 0 {
+			continue
+		}
+		nodes = append(nodes, gox.Input(gox.Type("hidden"), gox.Name(field.Name), gox.Value(file.Stash)))
+	}
+	return gox.Fragment(nodes...)
+}
+
+func (s *FileStash) Cleanup() error {
+	entries, err := os.ReadDir(s.dir)
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		if entry.IsDir() || !isStashKeyValid(entry.Name()) {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			return err
+		}
+		if s.now().Sub(info.ModTime()) < s.expiry {
+			continue
+		}
+		if err := os.Remove(filepath.Join(s.dir, entry.Name())); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+	return nil
+}
+
+func (s *FileStash) put(file Multipart) (Multipart, error) {
+	key := make([]byte, stashKeyLength)
+	if _, err := rand.Read(key); err != nil {
+		return file, fmt.Errorf("error while generating stash key: %w", err)
+	}
+	reference := stashReference{
+		Key:          hex.EncodeToString(key),
+		Name:         file.Name,
+		OriginalName: file.OriginalName,
+		Type:         file.Type,
+		Suffix:       file.Suffix,
+		Hash:         file.Hash,
+		Size:         file.size(),
+		Width:        file.Width,
+		Height:       file.Height,
+		Expires:      s.now().Add(s.expiry).Unix(),
+	}
+	if err := os.MkdirAll(s.dir, 0o755); err != nil {
+		return file, fmt.Errorf("error while creating stash dir: %w", err)
+	}
+	src, err := file.Open()
+	if err != nil {
+		return file, fmt.Errorf("error while opening stashed file: %w", err)
+	}
+	defer src.Close()
+	path := filepath.Join(s.dir, reference.Key)
+	dst, err := os.Create(path)
+	if err != nil {
+		return file, fmt.Errorf("error while creating stashed file: %w", err)
+	}
+	if _, err := io.Copy(dst, src); err != nil {
+		_ = dst.Close()
+		_ = os.Remove(path)
+		return file, fmt.Errorf("error while writing stashed file: %w", err)
+	}
+	if err := dst.Close(); err != nil {
+		return file, fmt.Errorf("error while writing stashed file: %w", err)
+	}
+	token, err := s.sign(reference)
+	if err != nil {
+		return file, err
+	}
+	if file.temp {
+		if err := file.Remove(); err != nil {
+			return file, fmt.Errorf("error while removing stashed multipart source: %w", err)
+		}
+		file.Path = path
+		file.temp = false
+	}
+	file.Stash = token
+	return file, nil
+}
+
+func (s *FileStash) restore(token string) (Multipart, error) {
+	payload, signature, ok := strings.Cut(token, ".")
+	if !ok || !hmac.Equal([]byte(signature), []byte(s.signature(payload))) {
+		return Multipart{}, errInvalidStashReference
+	}
+	data, err := base64.RawURLEncoding.DecodeString(payload)
+	if err != nil {
+		return Multipart{}, errInvalidStashReference
+	}
+	reference := stashReference{}
+	if err := json.Unmarshal(data, &reference); err != nil || !isStashKeyValid(reference.Key) {
+		return Multipart{}, errInvalidStashReference
+	}
+	if s.now().Unix() > reference.Expires {
+		return Multipart{}, fmt.Errorf("stash reference expired")
+	}
+	path := filepath.Join(s.dir, reference.Key)
+	if _, err := os.Stat(path); err != nil {
+		return Multipart{}, fmt.Errorf("error while restoring stashed file: %w", err)
+	}
+	return Multipart{
+		Name:         reference.Name,
+		OriginalName: reference.OriginalName,
+		Type:         reference.Type,
+		Suffix:       reference.Suffix,
+		Path:         path,
+		Hash:         reference.Hash,
+		Stash:        token,
+		Width:        reference.Width,
+		Height:       reference.Height,
+		Size:         reference.Size,
+	}, nil
+}
+
+func (s *FileStash) sign(reference stashReference) (string, error) {
+	data, err := json.Marshal(reference)
+	if err != nil {
+		return "", fmt.Errorf("error while creating stash reference: %w", err)
+	}
+	payload := base64.RawURLEncoding.EncodeToString(data)
+	return payload + "." + s.signature(payload), nil
+}
+
+func (s *FileStash) signature(payload string) string {
+	h := hmac.New(sha256.New, s.secret)
+	h.Write([]byte(payload))
+	return base64.RawURLEncoding.EncodeToString(h.Sum(nil))
+}
+
+func isStashKeyValid(key string) bool {
+	if len(key) != stashKeyLength*2 {
+		return false
+	}
+	_, err := hex.DecodeString(key)
+	return err == nil
+}
+
+func hasStashField(fields []*FieldBuilder) bool {
+	for _, field := range fields {
+		if field.stash != nil {
+			return true
+		}
+		if field.group != nil && hasStashField(field.group.fields) {
+			return true
+		}
+	}
+	return false
+}
+
+func stashFormFiles(fields []*FieldBuilder) error {
+	for _, field := range fields {
+		if field.group != nil {
+			for _, item := range field.group.items {
+				if err := stashFormFiles(item.fields); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+		if field.stash == nil || !field.valid {
+			continue
+		}
+		switch fv := field.value.(type) {
+		case Multipart:
+			file, err := stashFile(field.stash, fv)
+			if err != nil {
+				return err
+			}
+			field.value = file
+		case []Multipart:
+			files := make([]Multipart, len(fv))
+			for i, item := range fv {
+				file, err := stashFile(field.stash, item)
+				if err != nil {
+					return err
+				}
+				files[i] = file
+			}
+			field.value = files
+		}
+	}
+	return nil
+}
+
+func stashFile(stash *FileStash, file Multipart) (Multipart, error) {
+	if file.isEmpty() || len(file.Stash) > 0 {
+		return file, nil
+	}
+	return stash.put(file)
+}
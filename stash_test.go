@@ -0,0 +1,80 @@
+package form
+
+import (
+	"io"
+	"net/url"
+	"os"
+	"strings"
+	"testing"
+	"time"
+	
+	"github.com/stretchr/testify/assert"
+	
+	"github.com/creamsensation/gox"
+)
+
+func TestStash(t *testing.T) {
+	t.Run(
+		"stash and restore", func(t *testing.T) {
+			stash := NewFileStash(t.TempDir(), []byte("secret"))
+			fileBytes, req, err := testCreateMultipartRequest()
+			assert.Nil(t, err)
+			form, err := Build[testForm](testCreateStashBuilder(stash).Request(req))
+			assert.Nil(t, err)
+			assert.False(t, form.Valid)
+			assert.NotEqual(t, "", form.Test.Value.Stash)
+			assert.Equal(t, 0, len(form.Test.Messages))
+			node := gox.Render(StashNode(form.Test))
+			assert.Contains(t, node, `type="hidden"`)
+			assert.Contains(t, node, `name="test"`)
+			req = testCreateValuesRequest(url.Values{"email": {"test@test.cz"}, "test": {form.Test.Value.Stash}})
+			restored, err := Build[testForm](testCreateStashBuilder(stash).Request(req))
+			assert.Nil(t, err)
+			assert.True(t, restored.Valid)
+			assert.Equal(t, "test.txt", restored.Test.Value.Name)
+			assert.Equal(t, form.Test.Value.Hash, restored.Test.Value.Hash)
+			assert.Equal(t, int64(len(fileBytes)), restored.Test.Value.Size)
+			reader, err := restored.Test.Value.Open()
+			assert.Nil(t, err)
+			data, err := io.ReadAll(reader)
+			assert.Nil(t, err)
+			assert.Nil(t, reader.Close())
+			assert.Equal(t, fileBytes, data)
+		},
+	)
+	t.Run(
+		"invalid reference", func(t *testing.T) {
+			stash := NewFileStash(t.TempDir(), []byte("secret"))
+			file, err := stash.put(Multipart{Name: "test.txt", Data: []byte("test")})
+			assert.Nil(t, err)
+			payload, _, _ := strings.Cut(file.Stash, ".")
+			_, err = stash.restore(payload + ".forged")
+			assert.NotNil(t, err)
+			_, err = NewFileStash(stash.dir, []byte("other")).restore(file.Stash)
+			assert.NotNil(t, err)
+			req := testCreateValuesRequest(url.Values{"email": {"test@test.cz"}, "test": {payload + ".forged"}})
+			form, err := Build[testForm](testCreateStashBuilder(stash).Request(req))
+			assert.Nil(t, err)
+			assert.Equal(t, []string{defaultRequiredMessage}, form.Test.Messages)
+		},
+	)
+	t.Run(
+		"expiry", func(t *testing.T) {
+			stash := NewFileStash(t.TempDir(), []byte("secret"), time.Minute)
+			file, err := stash.put(Multipart{Name: "test.txt", Data: []byte("test")})
+			assert.Nil(t, err)
+			stash.now = func() time.Time {
+				return time.Now().Add(2 * time.Minute)
+			}
+			_, err = stash.restore(file.Stash)
+			assert.NotNil(t, err)
+			entries, err := os.ReadDir(stash.dir)
+			assert.Nil(t, err)
+			assert.Equal(t, 1, len(entries))
+			assert.Nil(t, stash.Cleanup())
+			entries, err = os.ReadDir(stash.dir)
+			assert.Nil(t, err)
+			assert.Equal(t, 0, len(entries))
+		},
+	)
+}
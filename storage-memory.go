@@ -0,0 +1,63 @@
+package form
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"io"
+	"strings"
+	"sync"
+)
+
+type MemoryStorage struct {
+	mu    sync.RWMutex
+	url   string
+	files map[string][]byte
+}
+
+func NewMemoryStorage(url ...string) *MemoryStorage {
+	s := &MemoryStorage{
+		files: make(map[string][]byte),
+	}
+	if len(url) > 0 {
+		s.url = url[0]
+	}
+	return s
+}
+
+func (s *MemoryStorage) Put(_ context.Context, key string, file Multipart) error {
+	src, err := file.Open()
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+	data, err := io.ReadAll(src)
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.files[key] = data
+	return nil
+}
+
+func (s *MemoryStorage) Get(_ context.Context, key string) (io.ReadCloser, error) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	data, ok := s.files[key]
+	if !ok {
+		return nil, fmt.Errorf("stored file %s not found", key)
+	}
+	return io.NopCloser(bytes.NewReader(data)), nil
+}
+
+func (s *MemoryStorage) Delete(_ context.Context, key string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.files, key)
+	return nil
+}
+
+func (s *MemoryStorage) Url(key string) string {
+	return strings.TrimSuffix(s.url, "/") + "/" + key
+}
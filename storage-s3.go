@@ -0,0 +1,184 @@
+package form
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+	"time"
+)
+
+type S3Config struct {
+	Endpoint  string
+	Region    string
+	Bucket    string
+	AccessKey string
+	SecretKey string
+	PublicUrl string
+	Client    *http.Client
+}
+
+type S3Storage struct {
+	config S3Config
+	client *http.Client
+	now    func() time.Time
+}
+
+const (
+	s3Algorithm       = "AWS4-HMAC-SHA256"
+	s3Service         = "s3"
+	s3Request         = "aws4_request"
+	s3UnsignedPayload = "UNSIGNED-PAYLOAD"
+	s3DateFormat      = "20060102"
+	s3TimeFormat      = "20060102T150405Z"
+	s3SignedHeaders   = "host;x-amz-content-sha256;x-amz-date"
+	s3HeaderDate      = "X-Amz-Date"
+	s3HeaderSha256    = "X-Amz-Content-Sha256"
+	s3HeaderAuth      = "Authorization"
+)
+
+func NewS3Storage(config S3Config) *S3Storage {
+	client := config.Client
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &S3Storage{
+		config: config,
+		client: client,
+		now:    time.Now,
+	}
+}
+
+func (s *S3Storage) Put(ctx context.Context, key string, file Multipart) error {
+	src, err := file.Open()
+	if err != nil {
+		return err
+	}
+	defer src.Close()
+	req, err := http.NewRequestWithContext(ctx, http.MethodPut, s.objectUrl(key), src)
+	if err != nil {
+		return err
+	}
+	req.ContentLength = file.Size
+	if req.ContentLength == 0 {
+		req.ContentLength = int64(len(file.Data))
+	}
+	if len(file.Type) > 0 {
+		req.Header.Set(contentType, file.Type)
+	}
+	_, err = s.do(req)
+	return err
+}
+
+func (s *S3Storage) Get(ctx context.Context, key string) (io.ReadCloser, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, s.objectUrl(key), nil)
+	if err != nil {
+		return nil, err
+	}
+	res, err := s.do(req)
+	if err != nil {
+		return nil, err
+	}
+	return res.Body, nil
+}
+
+func (s *S3Storage) Delete(ctx context.Context, key string) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodDelete, s.objectUrl(key), nil)
+	if err != nil {
+		return err
+	}
+	res, err := s.do(req)
+	if err != nil {
+		return err
+	}
+	return res.Body.Close()
+}
+
+func (s *S3Storage) Url(key string) string {
+	if len(s.config.PublicUrl) > 0 {
+		return strings.TrimSuffix(s.config.PublicUrl, "/") + "/" + escapeS3Path(key)
+	}
+	return s.objectUrl(key)
+}
+
+func (s *S3Storage) objectUrl(key string) string {
+	return strings.TrimSuffix(s.config.Endpoint, "/") + "/" + escapeS3Path(s.config.Bucket+"/"+key)
+}
+
+func (s *S3Storage) do(req *http.Request) (*http.Response, error) {
+	signS3Request(req, s.config.Region, s.config.AccessKey, s.config.SecretKey, s.now())
+	res, err := s.client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("error while calling s3 storage: %w", err)
+	}
+	if res.StatusCode < 200 || res.StatusCode > 299 {
+		body, _ := io.ReadAll(io.LimitReader(res.Body, 1<<10))
+		_ = res.Body.Close()
+		return nil, fmt.Errorf("s3 storage responded with status %d: %s", res.StatusCode, body)
+	}
+	return res, nil
+}
+
+func signS3Request(req *http.Request, region, accessKey, secretKey string, now time.Time) {
+	date := now.UTC().Format(s3DateFormat)
+	scope := strings.Join([]string{date, region, s3Service, s3Request}, "/")
+	req.Header.Set(s3HeaderDate, now.UTC().Format(s3TimeFormat))
+	req.Header.Set(s3HeaderSha256, s3UnsignedPayload)
+	req.Header.Set(
+		s3HeaderAuth,
+		fmt.Sprintf(
+			"%s Credential=%s/%s, SignedHeaders=%s, Signature=%s",
+			s3Algorithm, accessKey, scope, s3SignedHeaders, createS3Signature(req, date, region, secretKey),
+		),
+	)
+}
+
+func createS3Signature(req *http.Request, date, region, secretKey string) string {
+	scope := strings.Join([]string{date, region, s3Service, s3Request}, "/")
+	canonicalRequest := strings.Join(
+		[]string{
+			req.Method,
+			escapeS3Path(req.URL.Path),
+			strings.ReplaceAll(req.URL.Query().Encode(), "+", "%20"),
+			"host:" + req.URL.Host,
+			"x-amz-content-sha256:" + req.Header.Get(s3HeaderSha256),
+			"x-amz-date:" + req.Header.Get(s3HeaderDate),
+			"",
+			s3SignedHeaders,
+			req.Header.Get(s3HeaderSha256),
+		},
+		"\n",
+	)
+	canonicalHash := sha256.Sum256([]byte(canonicalRequest))
+	stringToSign := strings.Join(
+		[]string{s3Algorithm, req.Header.Get(s3HeaderDate), scope, hex.EncodeToString(canonicalHash[:])},
+		"\n",
+	)
+	key := hmacSha256([]byte("AWS4"+secretKey), date)
+	key = hmacSha256(key, region)
+	key = hmacSha256(key, s3Service)
+	key = hmacSha256(key, s3Request)
+	return hex.EncodeToString(hmacSha256(key, stringToSign))
+}
+
+func escapeS3Path(path string) string {
+	var b strings.Builder
+	for _, c := range []byte(path) {
+		if 'A' <= c && c <= 'Z' || 'a' <= c && c <= 'z' || '0' <= c && c <= '9' || strings.IndexByte("-._~/", c) > -1 {
+			b.WriteByte(c)
+			continue
+		}
+		b.WriteString(fmt.Sprintf("%%%02X", c))
+	}
+	return b.String()
+}
+
+func hmacSha256(key []byte, value string) []byte {
+	h := hmac.New(sha256.New, key)
+	h.Write([]byte(value))
+	return h.Sum(nil)
+}
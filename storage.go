@@ -0,0 +1,174 @@
+package form
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"path/filepath"
+	"strings"
+)
+
+type Storage interface {
+	Put(ctx context.Context, key string, file Multipart) error
+	Get(ctx context.Context, key string) (io.ReadCloser, error)
+	Delete(ctx context.Context, key string) error
+	Url(key string) string
+}
+
+const (
+	storageKeyLength = 16
+)
+
+func (b *FieldBuilder) Storage(storage Storage) *FieldBuilder {
+	b.storage = storage
+	return b
+}
+
+func createStorageKey(file Multipart) (string, error) {
+	key := make([]byte, storageKeyLength)
+	if _, err := rand.Read(key); err != nil {
+		return "", fmt.Errorf("error while generating storage key: %w", err)
+	}
+	if len(file.Suffix) == 0 {
+		return hex.EncodeToString(key), nil
+	}
+	return hex.EncodeToString(key) + "." + strings.ToLower(file.Suffix), nil
+}
+
+func hasStorageField(fields []*FieldBuilder) bool {
+	for _, field := range fields {
+		if field.storage != nil {
+			return true
+		}
+		if field.group != nil && hasStorageField(field.group.fields) {
+			return true
+		}
+	}
+	return false
+}
+
+type storedObject struct {
+	storage Storage
+	key     string
+}
+
+func storeFormFiles(ctx context.Context, fields []*FieldBuilder) error {
+	stored := make([]storedObject, 0)
+	if err := storeFieldsFiles(ctx, fields, &stored); err != nil {
+		for _, object := range stored {
+			_ = object.storage.Delete(ctx, object.key)
+		}
+		return err
+	}
+	return removeStoredSources(fields)
+}
+
+func storeFieldsFiles(ctx context.Context, fields []*FieldBuilder, stored *[]storedObject) error {
+	for _, field := range fields {
+		if field.group != nil {
+			for _, item := range field.group.items {
+				if err := storeFieldsFiles(ctx, item.fields, stored); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+		if field.storage == nil {
+			continue
+		}
+		switch fv := field.value.(type) {
+		case Multipart:
+			file, err := storeFile(ctx, field.storage, fv, stored)
+			if err != nil {
+				return err
+			}
+			field.value = file
+		case []Multipart:
+			files := make([]Multipart, len(fv))
+			for i, item := range fv {
+				file, err := storeFile(ctx, field.storage, item, stored)
+				if err != nil {
+					return err
+				}
+				files[i] = file
+			}
+			field.value = files
+		}
+	}
+	return nil
+}
+
+func storeFile(ctx context.Context, storage Storage, file Multipart, stored *[]storedObject) (Multipart, error) {
+	if file.isEmpty() || len(file.StorageKey) > 0 {
+		return file, nil
+	}
+	key, err := createStorageKey(file)
+	if err != nil {
+		return file, err
+	}
+	if err := storage.Put(ctx, key, file); err != nil {
+		return file, fmt.Errorf("error while storing multipart file: %w", err)
+	}
+	*stored = append(*stored, storedObject{storage: storage, key: key})
+	file.StorageKey = key
+	file.Url = storage.Url(key)
+	return file, nil
+}
+
+func removeStoredSources(fields []*FieldBuilder) error {
+	for _, field := range fields {
+		if field.group != nil {
+			for _, item := range field.group.items {
+				if err := removeStoredSources(item.fields); err != nil {
+					return err
+				}
+			}
+			continue
+		}
+		if field.storage == nil {
+			continue
+		}
+		switch fv := field.value.(type) {
+		case Multipart:
+			file, err := removeStoredSource(field.uploads, fv)
+			if err != nil {
+				return fmt.Errorf("error while removing stored multipart source: %w", err)
+			}
+			field.value = file
+		case []Multipart:
+			files := make([]Multipart, len(fv))
+			for i, item := range fv {
+				file, err := removeStoredSource(field.uploads, item)
+				if err != nil {
+					return fmt.Errorf("error while removing stored multipart source: %w", err)
+				}
+				files[i] = file
+			}
+			field.value = files
+		}
+	}
+	return nil
+}
+
+func removeStoredSource(uploads *UploadHandler, file Multipart) (Multipart, error) {
+	id := filepath.Base(file.Path)
+	switch {
+	case len(file.StorageKey) == 0:
+		return file, nil
+	case file.temp:
+		if err := file.Remove(); err != nil {
+			return file, err
+		}
+	case uploads != nil && len(file.Path) > 0 && file.Path == uploads.dataPath(id):
+		if err := uploads.Remove(id); err != nil {
+			return file, err
+		}
+	default:
+		return file, nil
+	}
+	file.Path = ""
+	file.temp = false
+	return file, nil
+}
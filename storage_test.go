@@ -0,0 +1,261 @@
+package form
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+	
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStorage(t *testing.T) {
+	type testStorageModel struct {
+		Name string
+		Test string
+	}
+	t.Run(
+		"memory storage", func(t *testing.T) {
+			fileBytes, req, err := testCreateMultipartRequest()
+			assert.Nil(t, err)
+			storage := NewMemoryStorage("/uploads")
+			form, err := Build[testForm](
+				New(
+					Add("name").With(Text()),
+					Add("test").With(File(), Validate.Required()).Storage(storage),
+				).Request(req),
+			)
+			assert.Nil(t, err)
+			assert.True(t, strings.HasSuffix(form.Test.Value.StorageKey, ".txt"))
+			assert.Equal(t, "/uploads/"+form.Test.Value.StorageKey, form.Test.Value.Url)
+			stored, err := storage.Get(context.Background(), form.Test.Value.StorageKey)
+			assert.Nil(t, err)
+			data, err := io.ReadAll(stored)
+			assert.Nil(t, err)
+			assert.Equal(t, fileBytes, data)
+			result := CreateStruct[testForm, testStorageModel](&form)
+			assert.Equal(t, form.Test.Value.Url, result.Test)
+		},
+	)
+	t.Run(
+		"invalid form is not stored", func(t *testing.T) {
+			_, req, err := testCreateMultipartRequest()
+			assert.Nil(t, err)
+			storage := NewMemoryStorage()
+			form, err := Build[testForm](
+				New(
+					Add("email").With(Email(), Validate.Required()),
+					Add("test").With(File()).Storage(storage),
+				).Request(req),
+			)
+			assert.Nil(t, err)
+			assert.False(t, form.Valid)
+			assert.Equal(t, "", form.Test.Value.StorageKey)
+			assert.Equal(t, 0, len(storage.files))
+		},
+	)
+	t.Run(
+		"local storage", func(t *testing.T) {
+			fileBytes, req, err := testCreateMultipartRequest()
+			assert.Nil(t, err)
+			dir := t.TempDir()
+			form, err := Build[testForm](
+				New(
+					Add("test").With(File()).Storage(NewLocalStorage(dir, "/static/")),
+				).Request(req),
+			)
+			assert.Nil(t, err)
+			data, err := os.ReadFile(filepath.Join(dir, form.Test.Value.StorageKey))
+			assert.Nil(t, err)
+			assert.Equal(t, fileBytes, data)
+			assert.Equal(t, "/static/"+form.Test.Value.StorageKey, form.Test.Value.Url)
+			storage := NewLocalStorage(dir, "")
+			assert.Equal(t, filepath.Join(dir, "etc", "passwd"), storage.path("../../etc/passwd"))
+		},
+	)
+	t.Run(
+		"s3 storage", func(t *testing.T) {
+			var mu sync.Mutex
+			objects := make(map[string][]byte)
+			server := httptest.NewServer(
+				http.HandlerFunc(
+					func(w http.ResponseWriter, r *http.Request) {
+						auth := r.Header.Get(s3HeaderAuth)
+						signed := r.Clone(context.Background())
+						signed.URL.Host = r.Host
+						date := r.Header.Get(s3HeaderDate)[:len(s3DateFormat)]
+						if !strings.HasSuffix(auth, "Signature="+createS3Signature(signed, date, "eu-central-1", "secret")) ||
+							!strings.Contains(auth, "Credential=access/"+date+"/eu-central-1/s3/aws4_request") {
+							w.WriteHeader(http.StatusForbidden)
+							return
+						}
+						mu.Lock()
+						defer mu.Unlock()
+						switch r.Method {
+						case http.MethodPut:
+							data, _ := io.ReadAll(r.Body)
+							objects[r.URL.Path] = data
+						case http.MethodGet:
+							data, ok := objects[r.URL.Path]
+							if !ok {
+								w.WriteHeader(http.StatusNotFound)
+								return
+							}
+							_, _ = w.Write(data)
+						case http.MethodDelete:
+							delete(objects, r.URL.Path)
+							w.WriteHeader(http.StatusNoContent)
+						}
+					},
+				),
+			)
+			defer server.Close()
+			storage := NewS3Storage(
+				S3Config{
+					Endpoint:  server.URL,
+					Region:    "eu-central-1",
+					Bucket:    "uploads",
+					AccessKey: "access",
+					SecretKey: "secret",
+					PublicUrl: "https://cdn.example.com",
+				},
+			)
+			storage.now = func() time.Time {
+				return time.Date(2024, 1, 2, 3, 4, 5, 0, time.UTC)
+			}
+			fileBytes, req, err := testCreateMultipartRequest()
+			assert.Nil(t, err)
+			form, err := Build[testForm](
+				New(Add("test").With(File()).Storage(storage)).Request(req),
+			)
+			assert.Nil(t, err)
+			key := form.Test.Value.StorageKey
+			assert.Equal(t, fileBytes, objects["/uploads/"+key])
+			assert.Equal(t, "https://cdn.example.com/"+key, form.Test.Value.Url)
+			stored, err := storage.Get(context.Background(), key)
+			assert.Nil(t, err)
+			data, err := io.ReadAll(stored)
+			assert.Nil(t, err)
+			assert.Nil(t, stored.Close())
+			assert.Equal(t, fileBytes, data)
+			assert.Nil(t, storage.Delete(context.Background(), key))
+			_, err = storage.Get(context.Background(), key)
+			assert.NotNil(t, err)
+			storage.config.SecretKey = "wrong"
+			assert.NotNil(t, storage.Delete(context.Background(), key))
+		},
+	)
+	t.Run(
+		"s3 signature", func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, "https://examplebucket.s3.amazonaws.com/?lifecycle", nil)
+			req.Header.Set(s3HeaderDate, "20130524T000000Z")
+			req.Header.Set(s3HeaderSha256, "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855")
+			assert.Equal(
+				t,
+				"fea454ca298b7da1c68078a5d1bdbfbbe0d65c699e0f91ac7a200a0136783543",
+				createS3Signature(req, "20130524", "us-east-1", "wJalrXUtnFEMI/K7MDENG/bPxRfiCYEXAMPLEKEY"),
+			)
+			req = httptest.NewRequest(http.MethodGet, "https://examplebucket.s3.amazonaws.com/?max-keys=2&prefix=J", nil)
+			req.Header.Set(s3HeaderDate, "20130524T000000Z")
+			req.Header.Set(s3HeaderSha256, "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855")
+			assert.Equal(
+				t,
+				"34b48302e7b5fa45bde8084f4b7868a86f0a534bc59db6670ed5711ef69dc6f7",
+				createS3Signature(req, "20130524", "us-east-1", "wJalrXUtnFEMI/K7MDENG/bPxRfiCYEXAMPLEKEY"),
+			)
+		},
+	)
+	t.Run(
+		"failed put deletes stored files", func(t *testing.T) {
+			type testFilesForm struct {
+				Form
+				Test Field[[]Multipart]
+			}
+			bodyBuf := new(bytes.Buffer)
+			bodyWriter := multipart.NewWriter(bodyBuf)
+			for _, name := range []string{"first.txt", "second.txt"} {
+				file, err := bodyWriter.CreateFormFile("test", name)
+				assert.Nil(t, err)
+				_, err = file.Write(bytes.Repeat([]byte("test"), 1<<8))
+				assert.Nil(t, err)
+			}
+			assert.Nil(t, bodyWriter.Close())
+			req := httptest.NewRequest(http.MethodPost, "/test", bodyBuf)
+			req.Header.Set(contentType, bodyWriter.FormDataContentType())
+			storage := &testPathStorage{MemoryStorage: NewMemoryStorage(), fail: 2}
+			_, err := Build[testFilesForm](
+				New(Add("test").Multiple().With(File()).Storage(storage)).Stream(16).Request(req),
+			)
+			assert.NotNil(t, err)
+			assert.Equal(t, 2, len(storage.paths))
+			assert.Equal(t, 0, len(storage.files))
+			_, err = os.Stat(storage.paths[0])
+			assert.True(t, os.IsNotExist(err))
+		},
+	)
+	t.Run(
+		"stored group file", func(t *testing.T) {
+			type testAttachmentForm struct {
+				Test Field[Multipart]
+			}
+			type testGroupFilesForm struct {
+				Form
+				Name       Field[string]
+				Attachment Field[testAttachmentForm]
+			}
+			bodyBuf := new(bytes.Buffer)
+			bodyWriter := multipart.NewWriter(bodyBuf)
+			assert.Nil(t, bodyWriter.WriteField("name", testNameValue))
+			file, err := bodyWriter.CreateFormFile("attachment.test", "test.txt")
+			assert.Nil(t, err)
+			_, err = file.Write([]byte("test"))
+			assert.Nil(t, err)
+			assert.Nil(t, bodyWriter.Close())
+			req := httptest.NewRequest(http.MethodPost, "/test", bodyBuf)
+			req.Header.Set(contentType, bodyWriter.FormDataContentType())
+			storage := NewMemoryStorage("/files")
+			form, err := Build[testGroupFilesForm](
+				New(
+					Add("name").With(Text()),
+					Add("attachment").With(Group(Add("test").With(File()).Storage(storage))),
+				).Request(req),
+			)
+			assert.Nil(t, err)
+			assert.True(t, form.Valid)
+			assert.Equal(t, testNameValue, form.Name.Value)
+			key := form.Attachment.Value.Test.Value.StorageKey
+			assert.NotEqual(t, "", key)
+			assert.Equal(t, "/files/"+key, form.Attachment.Value.Test.Value.Url)
+			assert.Equal(t, []byte("test"), storage.files[key])
+		},
+	)
+	t.Run(
+		"stored temp file is removed", func(t *testing.T) {
+			fileBytes, req, err := testCreateMultipartRequest()
+			assert.Nil(t, err)
+			storage := &testPathStorage{MemoryStorage: NewMemoryStorage()}
+			form, err := Build[testForm](
+				New(Add("test").With(File()).Storage(storage)).Stream(16).Request(req),
+			)
+			assert.Nil(t, err)
+			assert.Equal(t, 1, len(storage.paths))
+			assert.NotEqual(t, "", storage.paths[0])
+			_, err = os.Stat(storage.paths[0])
+			assert.True(t, os.IsNotExist(err))
+			assert.Equal(t, "", form.Test.Value.Path)
+			stored, err := storage.Get(context.Background(), form.Test.Value.StorageKey)
+			assert.Nil(t, err)
+			data, err := io.ReadAll(stored)
+			assert.Nil(t, err)
+			assert.Equal(t, fileBytes, data)
+		},
+	)
+}
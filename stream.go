@@ -0,0 +1,112 @@
+package form
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/url"
+	"os"
+)
+
+const (
+	defaultStreamThreshold = 1 << 20
+	multipartSniffLength   = 512
+	multipartTempPattern   = "form-multipart-*"
+)
+
+func processMultipartStream(req *http.Request, limit int, threshold int64) (url.Values, map[string][]Multipart, error) {
+	data := make(url.Values)
+	files := make(map[string][]Multipart)
+	req.Body = http.MaxBytesReader(nil, req.Body, int64(limit)<<20)
+	reader, err := req.MultipartReader()
+	if err != nil {
+		return data, files, err
+	}
+	for {
+		part, err := reader.NextPart()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			removeMultipartFiles(files)
+			return data, make(map[string][]Multipart), fmt.Errorf("error while reading multipart part: %w", err)
+		}
+		name := part.FormName()
+		if len(name) == 0 {
+			continue
+		}
+		if len(part.FileName()) == 0 {
+			value, err := io.ReadAll(part)
+			if err != nil {
+				removeMultipartFiles(files)
+				return data, make(map[string][]Multipart), fmt.Errorf("error while reading multipart value: %w", err)
+			}
+			data.Add(name, string(value))
+			continue
+		}
+		file, err := streamMultipartFile(part, threshold)
+		if err != nil {
+			removeMultipartFiles(files)
+			return data, make(map[string][]Multipart), err
+		}
+		files[name] = append(files[name], file)
+	}
+	req.Form = data
+	req.PostForm = data
+	return data, files, nil
+}
+
+func streamMultipartFile(part *multipart.Part, threshold int64) (Multipart, error) {
+	result := Multipart{
+		Key:          part.FormName(),
+		Name:         sanitizeFileName(part.FileName()),
+		OriginalName: part.FileName(),
+	}
+	hash := sha256.New()
+	buf := new(bytes.Buffer)
+	size, err := io.CopyN(io.MultiWriter(buf, hash), part, threshold+1)
+	if err != nil && !errors.Is(err, io.EOF) {
+		return result, fmt.Errorf("error while reading multipart file: %w", err)
+	}
+	result.Size = size
+	result.Type = http.DetectContentType(buf.Bytes()[:min(buf.Len(), multipartSniffLength)])
+	result.Suffix = getFileSuffix(result.Name, result.Type)
+	if size <= threshold {
+		result.Data = buf.Bytes()
+	}
+	if size > threshold {
+		tmp, err := os.CreateTemp("", multipartTempPattern)
+		if err != nil {
+			return result, fmt.Errorf("error while creating multipart temp file: %w", err)
+		}
+		defer tmp.Close()
+		result.Path = tmp.Name()
+		result.temp = true
+		if _, err := tmp.Write(buf.Bytes()); err != nil {
+			_ = result.Remove()
+			return result, fmt.Errorf("error while writing multipart temp file: %w", err)
+		}
+		rest, err := io.Copy(io.MultiWriter(tmp, hash), part)
+		if err != nil {
+			_ = result.Remove()
+			return result, fmt.Errorf("error while writing multipart temp file: %w", err)
+		}
+		result.Size += rest
+	}
+	result.Hash = hex.EncodeToString(hash.Sum(nil))
+	result.readImageMetadata()
+	return result, nil
+}
+
+func removeMultipartFiles(files map[string][]Multipart) {
+	for _, items := range files {
+		for _, item := range items {
+			_ = item.Remove()
+		}
+	}
+}
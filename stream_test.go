@@ -0,0 +1,145 @@
+package form
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"testing"
+	"time"
+	
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStream(t *testing.T) {
+	createRequest := func(fileBytes []byte) *http.Request {
+		bodyBuf := new(bytes.Buffer)
+		bodyWriter := multipart.NewWriter(bodyBuf)
+		assert.Nil(t, bodyWriter.WriteField("name", testNameValue))
+		testFile, err := bodyWriter.CreateFormFile("test", "test.txt")
+		assert.Nil(t, err)
+		_, err = testFile.Write(fileBytes)
+		assert.Nil(t, err)
+		assert.Nil(t, bodyWriter.Close())
+		req := httptest.NewRequest(http.MethodPost, "/test", bodyBuf)
+		req.Header.Set(contentType, bodyWriter.FormDataContentType())
+		return req
+	}
+	t.Run(
+		"small file in memory", func(t *testing.T) {
+			fileBytes := bytes.Repeat([]byte("test"), 1<<8)
+			hash := sha256.Sum256(fileBytes)
+			form, err := Build[testForm](
+				New(
+					Add("name").With(Text()),
+					Add("test").With(File(), Validate.Required()),
+				).Stream().Request(createRequest(fileBytes)),
+			)
+			assert.Nil(t, err)
+			assert.True(t, form.Valid)
+			assert.Equal(t, testNameValue, form.Name.Value)
+			assert.Equal(t, fileBytes, form.Test.Value.Data)
+			assert.Equal(t, "", form.Test.Value.Path)
+			assert.Equal(t, int64(len(fileBytes)), form.Test.Value.Size)
+			assert.Equal(t, hex.EncodeToString(hash[:]), form.Test.Value.Hash)
+			assert.Equal(t, "txt", form.Test.Value.Suffix)
+		},
+	)
+	t.Run(
+		"large file in temp file", func(t *testing.T) {
+			fileBytes := bytes.Repeat([]byte("test"), 1<<12)
+			hash := sha256.Sum256(fileBytes)
+			form, err := Build[testForm](
+				New(
+					Add("test").With(File(), Validate.Required()),
+				).Stream(1024).Request(createRequest(fileBytes)),
+			)
+			assert.Nil(t, err)
+			assert.True(t, form.Valid)
+			assert.Nil(t, form.Test.Value.Data)
+			assert.NotEqual(t, "", form.Test.Value.Path)
+			assert.Equal(t, int64(len(fileBytes)), form.Test.Value.Size)
+			assert.Equal(t, hex.EncodeToString(hash[:]), form.Test.Value.Hash)
+			assert.Equal(t, "text/plain; charset=utf-8", form.Test.Value.Type)
+			reader, err := form.Test.Value.Open()
+			assert.Nil(t, err)
+			data, err := io.ReadAll(reader)
+			assert.Nil(t, err)
+			assert.Nil(t, reader.Close())
+			assert.Equal(t, fileBytes, data)
+			assert.Nil(t, form.Test.Value.Remove())
+			_, err = os.Stat(form.Test.Value.Path)
+			assert.True(t, os.IsNotExist(err))
+		},
+	)
+	t.Run(
+		"invalid removes temp file", func(t *testing.T) {
+			form, err := Build[testForm](
+				New(
+					Add("email").With(Email(), Validate.Required()),
+					Add("test").With(File()),
+				).Stream(1024).Request(createRequest(bytes.Repeat([]byte("test"), 1<<12))),
+			)
+			assert.Nil(t, err)
+			assert.False(t, form.Valid)
+			assert.NotEqual(t, "", form.Test.Value.Path)
+			_, err = os.Stat(form.Test.Value.Path)
+			assert.True(t, os.IsNotExist(err))
+		},
+	)
+	t.Run(
+		"request done removes temp file", func(t *testing.T) {
+			ctx, cancel := context.WithCancel(context.Background())
+			form, err := Build[testForm](
+				New(
+					Add("test").With(File()),
+				).Stream(1024).Request(createRequest(bytes.Repeat([]byte("test"), 1<<12)).WithContext(ctx)),
+			)
+			assert.Nil(t, err)
+			assert.True(t, form.Valid)
+			_, err = os.Stat(form.Test.Value.Path)
+			assert.Nil(t, err)
+			cancel()
+			assert.Eventually(
+				t, func() bool {
+					_, err := os.Stat(form.Test.Value.Path)
+					return os.IsNotExist(err)
+				}, time.Second, 10*time.Millisecond,
+			)
+		},
+	)
+	t.Run(
+		"stash moves temp file", func(t *testing.T) {
+			stash := NewFileStash(t.TempDir(), []byte("secret"))
+			fileBytes := bytes.Repeat([]byte("test"), 1<<12)
+			form, err := Build[testForm](
+				testCreateStashBuilder(stash).Stream(1024).Request(createRequest(fileBytes)),
+			)
+			assert.Nil(t, err)
+			assert.False(t, form.Valid)
+			assert.NotEqual(t, "", form.Test.Value.Stash)
+			restored, err := stash.restore(form.Test.Value.Stash)
+			assert.Nil(t, err)
+			assert.Equal(t, restored.Path, form.Test.Value.Path)
+			data, err := os.ReadFile(form.Test.Value.Path)
+			assert.Nil(t, err)
+			assert.Equal(t, fileBytes, data)
+		},
+	)
+	t.Run(
+		"body limit", func(t *testing.T) {
+			fileBytes := bytes.Repeat([]byte("test"), 1<<19)
+			_, err := Build[testForm](
+				New(
+					Add("test").With(File()),
+				).Limit(1).Stream(1024).Request(createRequest(fileBytes)),
+			)
+			assert.NotNil(t, err)
+		},
+	)
+}
@@ -2,13 +2,18 @@ package form
 
 import (
 	"bytes"
+	"context"
 	"fmt"
 	"mime/multipart"
 	"net/http"
 	"net/http/httptest"
+	"net/url"
+	"reflect"
 	"strings"
 )
 
+//go:generate go run ./cmd/formgen -type testForm=testModel,testForm=testOptionalModel,testInvoiceForm=testInvoiceModel,testCustomForm=testCustomModel,testEnumForm=testEnumModel,testNumberForm,testMoneyForm,testCheckboxForm,testBenchmarkForm -output generated_test.go
+
 type testForm struct {
 	Form
 	Roles    Field[[]string]
@@ -28,6 +33,171 @@ type testModel struct {
 	Checked  bool
 }
 
+type testLineForm struct {
+	Description Field[string]
+	Qty         Field[int]
+}
+
+type testAddressForm struct {
+	Street Field[string]
+	City   Field[string]
+}
+
+type testInvoiceForm struct {
+	Form
+	Lines   Field[[]testLineForm]
+	Address Field[testAddressForm]
+}
+
+type testNumberForm struct {
+	Form
+	Count    Field[int64]
+	Small    Field[int8]
+	Unsigned Field[uint]
+	Ratio    Field[float32]
+	Price    Field[Decimal]
+	Counts   Field[[]int64]
+}
+
+type testMoneyForm struct {
+	Form
+	Price    Field[Amount]
+	Discount Field[Decimal]
+}
+
+type testCustomForm struct {
+	Form
+	Owner    Field[testUserId]
+	Color    Field[testColor]
+	Slug     Field[testSlug]
+	Tags     Field[[]testSlug]
+	Quantity Field[testQuantity]
+}
+
+type testCustomModel struct {
+	Owner testUserId
+	Color testColor
+	Slug  string
+}
+
+type testUserId int64
+
+type testSlug string
+
+type testQuantity int
+
+type testColor struct {
+	R, G, B uint8
+}
+
+func (c testColor) MarshalText() ([]byte, error) {
+	return []byte(fmt.Sprintf("#%02x%02x%02x", c.R, c.G, c.B)), nil
+}
+
+func (c *testColor) UnmarshalText(text []byte) error {
+	if _, err := fmt.Sscanf(string(text), "#%02x%02x%02x", &c.R, &c.G, &c.B); err != nil {
+		return err
+	}
+	return nil
+}
+
+type testPathStorage struct {
+	*MemoryStorage
+	paths []string
+	fail  int
+}
+
+func (s *testPathStorage) Put(ctx context.Context, key string, file Multipart) error {
+	s.paths = append(s.paths, file.Path)
+	if len(s.paths) == s.fail {
+		return fmt.Errorf("put %d failed", s.fail)
+	}
+	return s.MemoryStorage.Put(ctx, key, file)
+}
+
+type testCheckboxForm struct {
+	Form
+	Roles  Field[[]string]
+	Agree  Field[bool]
+	Levels Field[[]testLevel]
+	Flags  Field[[]bool]
+}
+
+type testEnumForm struct {
+	Form
+	Status Field[testStatus]
+	Level  Field[testLevel]
+	Tags   Field[[]testStatus]
+}
+
+type testEnumModel struct {
+	Status testStatus
+	Level  testLevel
+}
+
+type testStatus string
+
+type testLevel int
+
+const (
+	testStatusDraft     testStatus = "draft"
+	testStatusPublished testStatus = "published"
+)
+
+type testBenchmarkForm struct {
+	Form
+	Text1    Field[string]
+	Text2    Field[string]
+	Text3    Field[string]
+	Text4    Field[string]
+	Text5    Field[string]
+	Text6    Field[string]
+	Text7    Field[string]
+	Text8    Field[string]
+	Text9    Field[string]
+	Text10   Field[string]
+	Email1   Field[string]
+	Email2   Field[string]
+	Email3   Field[string]
+	Email4   Field[string]
+	Email5   Field[string]
+	Number1  Field[int]
+	Number2  Field[int]
+	Number3  Field[int]
+	Number4  Field[int]
+	Number5  Field[int]
+	Number6  Field[int]
+	Number7  Field[int]
+	Number8  Field[int]
+	Number9  Field[int]
+	Number10 Field[int]
+	Amount1  Field[float64]
+	Amount2  Field[float64]
+	Amount3  Field[float64]
+	Amount4  Field[float64]
+	Amount5  Field[float64]
+}
+
+type testOptionalModel struct {
+	Quantity *int
+	Amount   *float64
+}
+
+type testLineModel struct {
+	Description string
+	Qty         int
+}
+
+type testAddressModel struct {
+	Street string
+	City   string
+}
+
+type testInvoiceModel struct {
+	Lines   []testLineModel
+	Address testAddressModel
+}
+
 const (
 	testAction        = "/test"
 	testName          = "test"
@@ -90,6 +260,16 @@ func testCreateMultipartRequest() ([]byte, *http.Request, error) {
 	return fileBytes, req, nil
 }
 
+func testCreateValuesRequest(values url.Values) *http.Request {
+	req := httptest.NewRequest(
+		http.MethodPost,
+		"/test",
+		strings.NewReader(values.Encode()),
+	)
+	req.Header.Set(contentType, contentTypeForm)
+	return req
+}
+
 func testCreateEmptyBuildRequest() *http.Request {
 	req := httptest.NewRequest(
 		http.MethodPost,
@@ -99,3 +279,171 @@ func testCreateEmptyBuildRequest() *http.Request {
 	req.Header.Set(contentType, contentTypeForm)
 	return req
 }
+
+func testCheckedOptions(options []Option) []bool {
+	result := make([]bool, len(options))
+	for i, option := range options {
+		result[i] = option.Checked
+	}
+	return result
+}
+
+func testCreateBenchmarkBuilder(req *http.Request) *Builder {
+	fields := make([]*FieldBuilder, 0, 30)
+	for i := 1; i <= 10; i++ {
+		fields = append(fields, Add(fmt.Sprintf("text%d", i)).With(Text(), Validate.Required(), Validate.Max(100)))
+	}
+	for i := 1; i <= 5; i++ {
+		fields = append(fields, Add(fmt.Sprintf("email%d", i)).With(Email(), Validate.Required(), Validate.Email()))
+	}
+	for i := 1; i <= 10; i++ {
+		fields = append(fields, Add(fmt.Sprintf("number%d", i)).With(Number[int](), Validate.Min(0), Validate.Max(1000)))
+	}
+	for i := 1; i <= 5; i++ {
+		fields = append(fields, Add(fmt.Sprintf("amount%d", i)).With(Number[float64](), Validate.Required()))
+	}
+	return New(fields...).Request(req)
+}
+
+func testCreateBenchmarkValues() url.Values {
+	values := make(url.Values)
+	for i := 1; i <= 10; i++ {
+		values.Set(fmt.Sprintf("text%d", i), "text value")
+		values.Set(fmt.Sprintf("number%d", i), fmt.Sprintf("%d", i*10))
+	}
+	for i := 1; i <= 5; i++ {
+		values.Set(fmt.Sprintf("email%d", i), fmt.Sprintf("user%d@example.com", i))
+		values.Set(fmt.Sprintf("amount%d", i), "99.95")
+	}
+	return values
+}
+
+func testCreateBindBuilder() *Builder {
+	return New(
+		Add("roles").Multiple().With(Text()),
+		Add("email").With(Email(), Validate.Email()),
+		Add("name").With(Text(), Validate.Required()),
+		Add("quantity").With(Number[int](), Validate.Min(1)),
+		Add("amount").With(Number[float64]()),
+		Add("checked").With(Checkbox()),
+		Add("other").With(Text()),
+	)
+}
+
+func testCreateCheckboxBuilder() *Builder {
+	return New(
+		Add("roles").With(
+			CheckboxGroup(
+				Option{Value: "admin", Label: "Admin"},
+				Option{Value: "editor", Label: "Editor", Checked: true},
+				Option{Value: "viewer", Label: "Viewer"},
+			),
+			Validate.Min(1),
+			Validate.Max(2),
+		),
+		Add("agree").With(Checkbox()).CheckboxValue("yes"),
+		Add("levels").With(
+			CheckboxGroupOf(EnumOption[testLevel]{Value: 1, Label: "Low", Checked: true}, EnumOption[testLevel]{Value: 2, Label: "High"}),
+			Validate.Max(1),
+		),
+		Add("flags").With(Checkbox(false, true, false)),
+	)
+}
+
+func testCreateCustomBuilder() *Builder {
+	return New(
+		Add("owner").With(Value[testUserId](), Validate.Required()),
+		Add("color").With(Value(testColor{R: 255})),
+		Add("slug").With(Value[testSlug]()),
+		Add("tags").Multiple().With(Value[testSlug]()),
+		Add("quantity").With(Number[testQuantity](), Validate.Min(1)),
+	)
+}
+
+func testCreateEnumBuilder() *Builder {
+	return New(
+		Add("status").With(
+			Enum(
+				EnumOption[testStatus]{Value: testStatusDraft, Label: "Draft", Checked: true},
+				EnumOption[testStatus]{Value: testStatusPublished, Label: "Published"},
+			),
+			Validate.Required(),
+		),
+		Add("level").With(
+			Enum(EnumOption[testLevel]{Value: 1, Label: "Low"}, EnumOption[testLevel]{Value: 2, Label: "High"}),
+			Validate.Required(),
+		),
+		Add("tags").Multiple().With(
+			Enum(EnumOption[testStatus]{Value: testStatusDraft}, EnumOption[testStatus]{Value: testStatusPublished}),
+		),
+	)
+}
+
+func testCreateErrorBuilder(values url.Values) *Builder {
+	return New(
+		Add("email").Id("email-input").Label("Email").With(Email(), Validate.Required()),
+		Add("name").With(Text(), Validate.Required()),
+		Add("lines").Multiple().With(Group(Add("qty").With(Number[int](), Validate.Min(1)))),
+	).Request(testCreateValuesRequest(values))
+}
+
+func testCreateFocusBuilder() *Builder {
+	return New(
+		Add("name").Autofocus().With(Text(), Validate.Required()),
+		Add("email").With(Email(), Validate.Email()),
+		Add("quantity").With(Number[int](), Validate.Min(1)),
+	)
+}
+
+func testCreateGroupActionBuilder() *Builder {
+	return New(
+		Add("lines").Multiple().With(
+			Group(
+				Add("description").With(Text(), Validate.Required()),
+				Add("qty").With(Number[int]()),
+			),
+		),
+	)
+}
+
+func testCreateInvoiceBuilder() *Builder {
+	return New(
+		Add("lines").Multiple().With(
+			Group(
+				Add("description").With(Text(), Validate.Required()),
+				Add("qty").With(Number[int](), Validate.Min(1)),
+			),
+			Validate.Min(1), Validate.Max(3),
+		),
+		Add("address").With(
+			Group(
+				Add("street").With(Text(), Validate.Required()),
+				Add("city").With(Text()),
+			),
+		),
+	)
+}
+
+func testCreateNumberBuilder() *Builder {
+	return New(
+		Add("count").With(Number[int64]()),
+		Add("small").With(Number[int8]()),
+		Add("unsigned").With(Number[uint]()),
+		Add("ratio").With(Number[float32]()),
+		Add("price").With(Number[Decimal](), Validate.Min(MustParseDecimal("0.01")), Validate.Max(int64(1000))),
+		Add("counts").Multiple().With(Number[int64]()),
+	)
+}
+
+func testCreateStashBuilder(stash *FileStash) *Builder {
+	return New(
+		Add("email").With(Email(), Validate.Required()),
+		Add("test").With(File(), Validate.Required()).Stash(stash),
+	)
+}
+
+func unregisterConverter[T any]() {
+	convertersMu.Lock()
+	defer convertersMu.Unlock()
+	delete(converters, reflect.TypeOf((*T)(nil)).Elem())
+}
@@ -0,0 +1,408 @@
+package form
+
+import (
+	"fmt"
+	"reflect"
+	"strings"
+	"sync"
+	"time"
+	
+	"github.com/creamsensation/gox"
+)
+
+type Renderer interface {
+	Field(field Field[any], control gox.Node) gox.Node
+	Control(field Field[any], nodes ...gox.Node) gox.Node
+	Fieldset(legend string, nodes ...gox.Node) gox.Node
+	Row(nodes ...gox.Node) gox.Node
+	Submit(label string, nodes ...gox.Node) gox.Node
+	Summary(form Form) gox.Node
+	Render(form Form, nodes ...gox.Node) gox.Node
+}
+
+type Theme struct {
+	Form       string
+	Wrapper    string
+	Label      string
+	Input      string
+	Select     string
+	Textarea   string
+	Check      string
+	CheckInput string
+	CheckLabel string
+	Invalid    string
+	Help       string
+	Error      string
+	Group      string
+	Legend     string
+	Grid       string
+	Column     string
+	Button     string
+	Alert      string
+}
+
+var (
+	ThemePlain     = Theme{}
+	ThemeBootstrap = Theme{
+		Wrapper:    "mb-3",
+		Label:      "form-label",
+		Input:      "form-control",
+		Select:     "form-select",
+		Textarea:   "form-control",
+		Check:      "form-check",
+		CheckInput: "form-check-input",
+		CheckLabel: "form-check-label",
+		Invalid:    "is-invalid",
+		Help:       "form-text",
+		Error:      "invalid-feedback d-block",
+		Group:      "mb-3",
+		Legend:     "form-label",
+		Grid:       "row",
+		Column:     "col",
+		Button:     "btn btn-primary",
+		Alert:      "alert alert-danger",
+	}
+	ThemeTailwind = Theme{
+		Form:       "space-y-4",
+		Label:      "block mb-1 text-sm font-medium text-gray-700",
+		Input:      "block w-full rounded-md border border-gray-300 px-3 py-2 text-sm focus:border-blue-500 focus:outline-none focus:ring-1 focus:ring-blue-500",
+		Select:     "block w-full rounded-md border border-gray-300 px-3 py-2 text-sm focus:border-blue-500 focus:outline-none focus:ring-1 focus:ring-blue-500",
+		Textarea:   "block w-full rounded-md border border-gray-300 px-3 py-2 text-sm focus:border-blue-500 focus:outline-none focus:ring-1 focus:ring-blue-500",
+		Check:      "flex items-center gap-2",
+		CheckInput: "h-4 w-4 rounded border-gray-300 text-blue-600 focus:ring-blue-500",
+		CheckLabel: "text-sm text-gray-700",
+		Invalid:    "border-red-500 focus:border-red-500 focus:ring-red-500",
+		Help:       "mt-1 text-sm text-gray-500",
+		Error:      "mt-1 text-sm text-red-600",
+		Group:      "space-y-2",
+		Legend:     "mb-1 text-sm font-medium text-gray-700",
+		Grid:       "grid grid-flow-col auto-cols-fr gap-4",
+		Button:     "rounded-md bg-blue-600 px-4 py-2 text-sm font-medium text-white hover:bg-blue-700",
+		Alert:      "rounded-md border border-red-200 bg-red-50 p-4 text-sm text-red-700",
+	}
+)
+
+var (
+	defaultRenderer   Renderer = ThemePlain
+	defaultRendererMu sync.RWMutex
+)
+
+func SetTheme(renderer Renderer) {
+	defaultRendererMu.Lock()
+	defer defaultRendererMu.Unlock()
+	defaultRenderer = renderer
+}
+
+func (b *FieldBuilder) Theme(theme Theme) *FieldBuilder {
+	b.theme = &theme
+	return b
+}
+
+func FieldNode[T any](form Form, field Field[T], nodes ...gox.Node) gox.Node {
+	if fb := findField(form.getFields(), field.Name); fb != nil && fb.group != nil {
+		return renderField(form, form.getRenderer(), fb, nodes...)
+	}
+	renderer, base := getFieldRenderer(form, field)
+	return renderer.Field(base, renderer.Control(base, nodes...))
+}
+
+func ControlNode[T any](form Form, field Field[T], nodes ...gox.Node) gox.Node {
+	renderer, base := getFieldRenderer(form, field)
+	return renderer.Control(base, nodes...)
+}
+
+func (t Theme) Field(field Field[any], control gox.Node) gox.Node {
+	switch {
+	case field.Type == fieldTypeHidden || isButtonFieldType(field.Type):
+		return control
+	case field.DataType == fieldDataTypeGroup || isOptionsField(field):
+		return gox.Div(
+			createClassNode(t.Wrapper),
+			gox.Fieldset(
+				gox.Id(field.InputId()),
+				createClassNode(t.Group),
+				gox.If(field.Type == fieldTypeRadio, gox.Attribute("role", "radiogroup")),
+				gox.If(field.Type == fieldTypeRadio && field.Required, gox.Attribute("aria-required", "true")),
+				field.describedBy(),
+				gox.If(len(field.Label) > 0, gox.Legend(createClassNode(t.Legend), gox.Text(field.Label))),
+				control,
+				field.HelpNode(createClassNode(t.Help)),
+				field.ErrorNode(createClassNode(t.Error)),
+			),
+		)
+	case field.Type == fieldTypeCheckbox:
+		return gox.Div(
+			createClassNode(t.Wrapper),
+			gox.Div(
+				createClassNode(t.Check),
+				control,
+				gox.If(
+					len(field.Label) > 0,
+					gox.Label(gox.For(field.InputId()), createClassNode(t.CheckLabel), gox.Text(field.Label)),
+				),
+			),
+			field.HelpNode(createClassNode(t.Help)),
+			field.ErrorNode(createClassNode(t.Error)),
+		)
+	}
+	return gox.Div(
+		createClassNode(t.Wrapper),
+		gox.If(
+			len(field.Label) > 0,
+			gox.Label(gox.For(field.InputId()), createClassNode(t.Label), gox.Text(field.Label)),
+		),
+		control,
+		field.HelpNode(createClassNode(t.Help)),
+		field.ErrorNode(createClassNode(t.Error)),
+	)
+}
+
+func (t Theme) Control(field Field[any], nodes ...gox.Node) gox.Node {
+	invalid := ""
+	if len(field.Messages) > 0 {
+		invalid = t.Invalid
+	}
+	attributes := gox.Fragment(
+		gox.Id(field.InputId()),
+		gox.Name(field.Name),
+		gox.If(field.Required && !isOptionsField(field), gox.Attribute("required", "required")),
+		gox.If(field.Disabled, gox.Attribute("disabled", "disabled")),
+		gox.If(field.Autofocus, gox.Attribute("autofocus", "autofocus")),
+		field.Aria(),
+	)
+	switch {
+	case field.DataType == fieldDataTypeGroup:
+		return gox.Fragment(nodes...)
+	case field.Type == fieldTypeCheckbox && field.DataType == fieldDataTypeBool && !field.Multiple:
+		checked, _ := field.Value.(bool)
+		value := defaultCheckboxValue
+		if len(field.Options) > 0 {
+			value = field.Options[0].Value
+		}
+		return gox.Input(
+			gox.Type(fieldTypeCheckbox),
+			attributes,
+			createClassNode(t.CheckInput, invalid),
+			gox.Value(value),
+			gox.If(checked, gox.Attribute("checked", "checked")),
+			gox.Fragment(nodes...),
+		)
+	case field.Type == fieldTypeSelect:
+		options := make([]gox.Node, len(field.Options))
+		for i, option := range field.Options {
+			options[i] = gox.Option(
+				gox.Value(option.Value),
+				gox.If(option.Checked, gox.Attribute("selected", "selected")),
+				gox.Text(option.Label),
+			)
+		}
+		return gox.Select(
+			attributes,
+			createClassNode(t.Select, invalid),
+			gox.If(field.Multiple, gox.Attribute("multiple", "multiple")),
+			gox.Fragment(nodes...),
+			gox.Fragment(options...),
+		)
+	case isOptionsField(field):
+		items := make([]gox.Node, len(field.Options))
+		for i, option := range field.Options {
+			id := fmt.Sprintf("%s-%d", field.InputId(), i)
+			items[i] = gox.Div(
+				createClassNode(t.Check),
+				gox.Input(
+					gox.Type(field.Type),
+					gox.Id(id),
+					gox.Name(field.Name),
+					createClassNode(t.CheckInput, invalid),
+					gox.Value(option.Value),
+					gox.If(option.Checked, gox.Attribute("checked", "checked")),
+					gox.If(field.Disabled, gox.Attribute("disabled", "disabled")),
+					gox.If(field.Autofocus && i == 0, gox.Attribute("autofocus", "autofocus")),
+					gox.If(len(field.Messages) > 0, gox.Attribute("aria-invalid", "true")),
+				),
+				gox.Label(gox.For(id), createClassNode(t.CheckLabel), gox.Text(option.Label)),
+			)
+		}
+		return gox.Fragment(gox.Fragment(items...), gox.Fragment(nodes...))
+	case field.Type == fieldTypeRichText:
+		return gox.Textarea(
+			attributes,
+			createClassNode(t.Textarea, invalid),
+			gox.Fragment(nodes...),
+			gox.Text(getControlValue(field)),
+		)
+	}
+	value := getControlValue(field)
+	input := gox.Input(
+		gox.Type(getInputType(field.Type)),
+		attributes,
+		createClassNode(t.Input, invalid),
+		gox.If(len(value) > 0, gox.Value(value)),
+		gox.If(field.Multiple && field.Type == fieldTypeFile, gox.Attribute("multiple", "multiple")),
+		gox.If(
+			field.DataType == fieldDataTypeFloat || field.DataType == fieldDataTypeDecimal,
+			gox.Attribute("step", "any"),
+		),
+		gox.If(field.Type == fieldTypeMoney, gox.Attribute("inputmode", "decimal")),
+		gox.Fragment(nodes...),
+	)
+	if amount, ok := field.Value.(Amount); ok && len(amount.Currency) > 0 {
+		return gox.Fragment(
+			input,
+			gox.Input(gox.Type(fieldTypeHidden), gox.Name(field.Name+moneyCurrencySuffix), gox.Value(amount.Currency)),
+		)
+	}
+	return input
+}
+
+func (t Theme) Fieldset(legend string, nodes ...gox.Node) gox.Node {
+	return gox.Fieldset(
+		createClassNode(t.Group),
+		gox.If(len(legend) > 0, gox.Legend(createClassNode(t.Legend), gox.Text(legend))),
+		gox.Fragment(nodes...),
+	)
+}
+
+func (t Theme) Row(nodes ...gox.Node) gox.Node {
+	columns := nodes
+	if len(t.Column) > 0 {
+		columns = make([]gox.Node, len(nodes))
+		for i, node := range nodes {
+			columns[i] = gox.Div(createClassNode(t.Column), node)
+		}
+	}
+	return gox.Div(
+		createClassNode(t.Grid),
+		gox.Fragment(columns...),
+	)
+}
+
+func (t Theme) Submit(label string, nodes ...gox.Node) gox.Node {
+	return gox.Button(
+		gox.Type(fieldTypeSubmit),
+		createClassNode(t.Button),
+		gox.Fragment(nodes...),
+		gox.Text(label),
+	)
+}
+
+func (t Theme) Summary(form Form) gox.Node {
+	return form.ErrorSummary(createClassNode(t.Alert))
+}
+
+func (t Theme) Render(form Form, nodes ...gox.Node) gox.Node {
+	return form.Node(createClassNode(t.Form), gox.Fragment(nodes...))
+}
+
+func (t Theme) Override(theme Theme) Theme {
+	result := t
+	target := reflect.ValueOf(&result).Elem()
+	source := reflect.ValueOf(theme)
+	for i := 0; i < source.NumField(); i++ {
+		if value := source.Field(i).String(); len(value) > 0 {
+			target.Field(i).SetString(value)
+		}
+	}
+	return result
+}
+
+func (f Form) getRenderer() Renderer {
+	if f.state != nil && f.state.renderer != nil {
+		return f.state.renderer
+	}
+	defaultRendererMu.RLock()
+	defer defaultRendererMu.RUnlock()
+	return defaultRenderer
+}
+
+func renderField(form Form, renderer Renderer, fb *FieldBuilder, nodes ...gox.Node) gox.Node {
+	renderer = overrideRenderer(renderer, fb)
+	field := createField[any](fb, fb.value, form.FieldMessages(fb.fullName()))
+	field.locale = form.Locale
+	if fb.group == nil {
+		return renderer.Field(field, renderer.Control(field, nodes...))
+	}
+	items := make([]gox.Node, len(fb.group.items))
+	for i, item := range fb.group.items {
+		path := getGroupItemPath(fb, i)
+		fields := make([]gox.Node, len(item.fields))
+		for j, itemField := range item.fields {
+			fields[j] = renderField(form, renderer, itemField)
+		}
+		items[i] = gox.Fragment(fields...)
+		if fb.multiple {
+			items[i] = gox.Div(gox.Id(getFieldId("", path)), items[i])
+		}
+	}
+	return renderer.Field(field, renderer.Control(field, gox.Fragment(items...), gox.Fragment(nodes...)))
+}
+
+func getFieldRenderer[T any](form Form, field Field[T]) (Renderer, Field[any]) {
+	renderer := form.getRenderer()
+	base := field.base()
+	fb := findField(form.getFields(), field.Name)
+	if fb == nil {
+		return renderer, base
+	}
+	base.Messages = form.FieldMessages(field.Name)
+	base.locale = form.Locale
+	return overrideRenderer(renderer, fb), base
+}
+
+func overrideRenderer(renderer Renderer, fb *FieldBuilder) Renderer {
+	theme, ok := renderer.(Theme)
+	if !ok || fb.theme == nil {
+		return renderer
+	}
+	return theme.Override(*fb.theme)
+}
+
+func createClassNode(classes ...string) gox.Node {
+	result := make([]string, 0, len(classes))
+	for _, class := range classes {
+		if len(class) > 0 {
+			result = append(result, class)
+		}
+	}
+	return gox.If(len(result) > 0, gox.Class(strings.Join(result, " ")))
+}
+
+func isOptionsField(field Field[any]) bool {
+	return len(field.Options) > 0 && field.Type != fieldTypeSelect && (field.DataType != fieldDataTypeBool || field.Multiple)
+}
+
+func isButtonFieldType(fieldType string) bool {
+	return fieldType == fieldTypeSubmit || fieldType == fieldTypeButton || fieldType == fieldTypeReset
+}
+
+func getInputType(fieldType string) string {
+	if fieldType == fieldTypeMoney {
+		return fieldTypeText
+	}
+	return fieldType
+}
+
+func getControlValue(field Field[any]) string {
+	switch {
+	case field.Type == fieldTypePassword || field.Type == fieldTypeFile:
+		return ""
+	case !field.Present && field.Value != nil && reflect.ValueOf(field.Value).IsZero():
+		return ""
+	}
+	if value, ok := field.Value.(time.Time); ok {
+		switch field.Type {
+		case fieldTypeDate:
+			return value.Format(time.DateOnly)
+		case fieldTypeDateTimeLocal:
+			return value.Format("2006-01-02T15:04")
+		case fieldTypeMonth:
+			return value.Format("2006-01")
+		case fieldTypeTime:
+			return value.Format("15:04")
+		}
+	}
+	if value, ok := field.Value.(Amount); ok {
+		return value.formatNumber(field.locale)
+	}
+	return field.String()
+}
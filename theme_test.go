@@ -0,0 +1,131 @@
+package form
+
+import (
+	"net/url"
+	"testing"
+	
+	"github.com/creamsensation/gox"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTheme(t *testing.T) {
+	t.Run(
+		"plain", func(t *testing.T) {
+			field := Field[any]{Type: fieldTypeEmail, Name: "email", Label: "Email", Value: "a@b.cz", Required: true}
+			assert.Equal(
+				t,
+				`<div><label for="email">Email</label><input type="email" id="email" name="email" required="required" aria-required="true" value="a@b.cz" /></div>`,
+				gox.Render(ThemePlain.Field(field, ThemePlain.Control(field))),
+			)
+		},
+	)
+	t.Run(
+		"bootstrap", func(t *testing.T) {
+			field := Field[any]{
+				Type:     fieldTypeText,
+				Name:     "name",
+				Label:    "Name",
+				Text:     "Full name",
+				Messages: []string{"Required"},
+			}
+			result := gox.Render(ThemeBootstrap.Field(field, ThemeBootstrap.Control(field)))
+			assert.Contains(t, result, `<div class="mb-3"><label for="name" class="form-label">Name</label>`)
+			assert.Contains(t, result, `class="form-control is-invalid"`)
+			assert.Contains(t, result, `<div id="name-help" class="form-text">Full name</div>`)
+			assert.Contains(t, result, `<div id="name-error" class="invalid-feedback d-block"><p>Required</p></div>`)
+			assert.Equal(
+				t,
+				`<button type="submit" class="btn btn-primary">Save</button>`,
+				gox.Render(ThemeBootstrap.Submit("Save")),
+			)
+			assert.Equal(
+				t,
+				`<div class="row"><div class="col">a</div><div class="col">b</div></div>`,
+				gox.Render(ThemeBootstrap.Row(gox.Text("a"), gox.Text("b"))),
+			)
+		},
+	)
+	t.Run(
+		"controls", func(t *testing.T) {
+			checkbox := Field[any]{Type: fieldTypeCheckbox, DataType: fieldDataTypeBool, Name: "agree", Label: "Agree", Value: true}
+			assert.Equal(
+				t,
+				`<div class="mb-3"><div class="form-check"><input type="checkbox" id="agree" name="agree" class="form-check-input" value="on" checked="checked" /><label for="agree" class="form-check-label">Agree</label></div></div>`,
+				gox.Render(ThemeBootstrap.Field(checkbox, ThemeBootstrap.Control(checkbox))),
+			)
+			radio := Field[any]{
+				Type:    fieldTypeRadio,
+				Name:    "level",
+				Label:   "Level",
+				Options: []Option{{Value: "1", Label: "Low", Checked: true}},
+			}
+			assert.Contains(
+				t,
+				gox.Render(ThemeBootstrap.Field(radio, ThemeBootstrap.Control(radio))),
+				`<fieldset id="level" class="mb-3" role="radiogroup"><legend class="form-label">Level</legend><div class="form-check"><input type="radio" id="level-0" name="level" class="form-check-input" value="1" checked="checked" />`,
+			)
+			password := Field[any]{Type: fieldTypePassword, Name: "password", Value: "secret", Present: true}
+			assert.Equal(
+				t,
+				`<input type="password" id="password" name="password" />`,
+				gox.Render(ThemePlain.Control(password)),
+			)
+			amount := Field[any]{Type: fieldTypeNumber, DataType: fieldDataTypeFloat, Name: "amount", Value: float64(0)}
+			assert.Equal(
+				t,
+				`<input type="number" id="amount" name="amount" step="any" />`,
+				gox.Render(ThemePlain.Control(amount)),
+			)
+		},
+	)
+	t.Run(
+		"override", func(t *testing.T) {
+			theme := ThemeBootstrap.Override(Theme{Input: "form-control-lg"})
+			assert.Equal(t, "form-control-lg", theme.Input)
+			assert.Equal(t, ThemeBootstrap.Label, theme.Label)
+		},
+	)
+	t.Run(
+		"builder and field", func(t *testing.T) {
+			form, err := Build[testForm](
+				New(
+					Add("name").With(Text(), Validate.Required()),
+					Add("email").With(Email()).Theme(Theme{Input: "email-input"}),
+				).Theme(ThemeBootstrap).Request(testCreateValuesRequest(url.Values{"email": {"a@b.cz"}})),
+			)
+			assert.Nil(t, err)
+			name := gox.Render(FieldNode(form.Form, form.Name))
+			assert.Contains(t, name, `class="form-control is-invalid"`)
+			assert.Contains(t, name, `autofocus="autofocus"`)
+			email := gox.Render(ControlNode(form.Form, form.Email))
+			assert.Contains(t, email, `class="email-input"`)
+			assert.Contains(t, email, `value="a@b.cz"`)
+		},
+	)
+	t.Run(
+		"global", func(t *testing.T) {
+			SetTheme(ThemeTailwind)
+			defer SetTheme(ThemePlain)
+			form, err := Build[testForm](New(Add("name").With(Text())).Request(testGetRequest()))
+			assert.Nil(t, err)
+			assert.Contains(t, gox.Render(ControlNode(form.Form, form.Name)), ThemeTailwind.Input)
+		},
+	)
+	t.Run(
+		"groups", func(t *testing.T) {
+			form, err := Build[testInvoiceForm](
+				New(
+					Add("lines").Multiple().With(Group(Add("description").With(Text()))),
+				).Request(testCreateValuesRequest(url.Values{"lines[0].description": {"First"}})),
+			)
+			assert.Nil(t, err)
+			result := gox.Render(FieldNode(form.Form, form.Lines))
+			assert.Contains(t, result, `<fieldset id="lines">`)
+			assert.Contains(
+				t,
+				result,
+				`<div id="lines-0"><div><input type="text" id="lines-0-description" name="lines[0].description" value="First" /></div></div>`,
+			)
+		},
+	)
+}
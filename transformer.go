@@ -0,0 +1,69 @@
+package form
+
+import (
+	"strings"
+	"unicode"
+	
+	"golang.org/x/text/unicode/norm"
+)
+
+type Transformer func(value string) string
+
+type Transformers struct{}
+
+var Transform = Transformers{}
+
+func (b *FieldBuilder) Transform(transformers ...Transformer) *FieldBuilder {
+	b.transformers = append(b.transformers, transformers...)
+	return b
+}
+
+func (t Transformers) Trim() Transformer {
+	return strings.TrimSpace
+}
+
+func (t Transformers) CollapseSpace() Transformer {
+	return func(value string) string {
+		return strings.Join(strings.Fields(value), " ")
+	}
+}
+
+func (t Transformers) Lower() Transformer {
+	return strings.ToLower
+}
+
+func (t Transformers) Upper() Transformer {
+	return strings.ToUpper
+}
+
+func (t Transformers) NFC() Transformer {
+	return norm.NFC.String
+}
+
+func (t Transformers) Digits() Transformer {
+	return func(value string) string {
+		return strings.Map(
+			func(r rune) rune {
+				if !unicode.IsDigit(r) {
+					return -1
+				}
+				return r
+			},
+			value,
+		)
+	}
+}
+
+func transformValues(fb *FieldBuilder, values []string) []string {
+	if len(fb.transformers) == 0 {
+		return values
+	}
+	result := make([]string, len(values))
+	for i, value := range values {
+		for _, transformer := range fb.transformers {
+			value = transformer(value)
+		}
+		result[i] = value
+	}
+	return result
+}
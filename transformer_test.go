@@ -0,0 +1,49 @@
+package form
+
+import (
+	"net/url"
+	"strings"
+	"testing"
+	
+	"github.com/stretchr/testify/assert"
+)
+
+func TestTransformer(t *testing.T) {
+	t.Run(
+		"built-in transformers", func(t *testing.T) {
+			assert.Equal(t, "test", Transform.Trim()("  test \n"))
+			assert.Equal(t, "a b c", Transform.CollapseSpace()(" a  b\t\nc "))
+			assert.Equal(t, "test", Transform.Lower()("TeSt"))
+			assert.Equal(t, "TEST", Transform.Upper()("TeSt"))
+			assert.Equal(t, "\u017e", Transform.NFC()("z\u030c"))
+			assert.Equal(t, "420123456789", Transform.Digits()("+420 123 456 789"))
+		},
+	)
+	t.Run(
+		"transform before validation", func(t *testing.T) {
+			req := testCreateValuesRequest(
+				url.Values{
+					"email":    {"  Test@Test.CZ "},
+					"name":     {"  Jan   Novak "},
+					"quantity": {"1 000"},
+					"roles":    {" Owner", "ADMIN "},
+				},
+			)
+			form, err := Build[testForm](
+				New(
+					Add("email").With(Email(), Validate.Email()).Transform(Transform.Trim(), Transform.Lower()),
+					Add("name").With(Text(), Validate.Max(10)).Transform(Transform.CollapseSpace()),
+					Add("quantity").With(Number[int]()).Transform(Transform.Digits()),
+					Add("roles").Multiple().With(Text()).Transform(strings.TrimSpace, Transform.Lower()),
+				).Request(req),
+			)
+			assert.Nil(t, err)
+			assert.True(t, form.Valid)
+			assert.Equal(t, "test@test.cz", form.Email.Value)
+			assert.Equal(t, "Jan Novak", form.Name.Value)
+			assert.Equal(t, 1000, form.Quantity.Value)
+			assert.Equal(t, []string{"owner", "admin"}, form.Roles.Value)
+			assert.Equal(t, " Owner", req.Form["roles"][0])
+		},
+	)
+}
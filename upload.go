@@ -0,0 +1,343 @@
+package form
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+)
+
+type UploadHandler struct {
+	dir     string
+	path    string
+	maxSize int64
+	locks   sync.Map
+}
+
+type upload struct {
+	Id     string `json:"id"`
+	Name   string `json:"name"`
+	Length int64  `json:"length"`
+}
+
+const (
+	uploadIdLength       = 16
+	uploadInfoSuffix     = ".info"
+	uploadVersion        = "1.0.0"
+	uploadExtensions     = "creation,termination"
+	uploadContentType    = "application/offset+octet-stream"
+	uploadHeaderResume   = "Tus-Resumable"
+	uploadHeaderVersion  = "Tus-Version"
+	uploadHeaderExt      = "Tus-Extension"
+	uploadHeaderMaxSize  = "Tus-Max-Size"
+	uploadHeaderLength   = "Upload-Length"
+	uploadHeaderOffset   = "Upload-Offset"
+	uploadHeaderMetadata = "Upload-Metadata"
+	uploadMetadataName   = "filename"
+)
+
+func NewUploadHandler(dir, path string, maxSize ...int64) *UploadHandler {
+	h := &UploadHandler{
+		dir:  dir,
+		path: strings.TrimSuffix(path, "/"),
+	}
+	if len(maxSize) > 0 {
+		h.maxSize = maxSize[0]
+	}
+	return h
+}
+
+func (b *FieldBuilder) Uploads(handler *UploadHandler) *FieldBuilder {
+	b.uploads = handler
+	return b
+}
+
+func (h *UploadHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	w.Header().Set(uploadHeaderResume, uploadVersion)
+	if r.Method == http.MethodOptions {
+		w.Header().Set(uploadHeaderVersion, uploadVersion)
+		w.Header().Set(uploadHeaderExt, uploadExtensions)
+		if h.maxSize > 0 {
+			w.Header().Set(uploadHeaderMaxSize, strconv.FormatInt(h.maxSize, 10))
+		}
+		w.WriteHeader(http.StatusNoContent)
+		return
+	}
+	if r.Header.Get(uploadHeaderResume) != uploadVersion {
+		w.Header().Set(uploadHeaderVersion, uploadVersion)
+		w.WriteHeader(http.StatusPreconditionFailed)
+		return
+	}
+	id := strings.Trim(strings.TrimPrefix(r.URL.Path, h.path), "/")
+	if len(id) == 0 {
+		if r.Method != http.MethodPost {
+			w.WriteHeader(http.StatusMethodNotAllowed)
+			return
+		}
+		h.create(w, r)
+		return
+	}
+	if !isUploadIdValid(id) {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	switch r.Method {
+	case http.MethodHead:
+		h.status(w, id)
+	case http.MethodPatch:
+		h.patch(w, r, id)
+	case http.MethodDelete:
+		h.delete(w, id)
+	default:
+		w.WriteHeader(http.StatusMethodNotAllowed)
+	}
+}
+
+func (h *UploadHandler) Multipart(id string) (Multipart, error) {
+	if !isUploadIdValid(id) {
+		return Multipart{}, fmt.Errorf("invalid upload id %s", id)
+	}
+	u, offset, err := h.read(id)
+	if err != nil {
+		return Multipart{}, err
+	}
+	if offset != u.Length {
+		return Multipart{}, fmt.Errorf("upload %s is not complete", id)
+	}
+	f, err := os.Open(h.dataPath(id))
+	if err != nil {
+		return Multipart{}, err
+	}
+	defer f.Close()
+	hash := sha256.New()
+	head := make([]byte, multipartSniffLength)
+	n, err := io.ReadFull(f, head)
+	if err != nil && !errors.Is(err, io.EOF) && !errors.Is(err, io.ErrUnexpectedEOF) {
+		return Multipart{}, err
+	}
+	hash.Write(head[:n])
+	if _, err := io.Copy(hash, f); err != nil {
+		return Multipart{}, err
+	}
+	name := sanitizeFileName(u.Name)
+	fileType := http.DetectContentType(head[:n])
+	result := Multipart{
+		Name:         name,
+		OriginalName: u.Name,
+		Type:         fileType,
+		Suffix:       getFileSuffix(name, fileType),
+		Path:         h.dataPath(id),
+		Hash:         hex.EncodeToString(hash.Sum(nil)),
+		Size:         u.Length,
+	}
+	result.readImageMetadata()
+	return result, nil
+}
+
+func (h *UploadHandler) Remove(id string) error {
+	if !isUploadIdValid(id) {
+		return fmt.Errorf("invalid upload id %s", id)
+	}
+	if err := os.Remove(h.dataPath(id)); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	if err := os.Remove(h.infoPath(id)); err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	h.locks.Delete(id)
+	return nil
+}
+
+func (h *UploadHandler) Cleanup(maxAge time.Duration) error {
+	entries, err := os.ReadDir(h.dir)
+	if err != nil {
+		return err
+	}
+	for _, entry := range entries {
+		id, ok := strings.CutSuffix(entry.Name(), uploadInfoSuffix)
+		if !ok || !isUploadIdValid(id) {
+			continue
+		}
+		info, err := entry.Info()
+		if err != nil {
+			return err
+		}
+		if time.Since(info.ModTime()) < maxAge {
+			continue
+		}
+		if stat, err := os.Stat(h.dataPath(id)); err == nil && time.Since(stat.ModTime()) < maxAge {
+			continue
+		}
+		if err := h.Remove(id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (h *UploadHandler) create(w http.ResponseWriter, r *http.Request) {
+	length, err := strconv.ParseInt(r.Header.Get(uploadHeaderLength), 10, 64)
+	if err != nil || length < 0 {
+		w.WriteHeader(http.StatusBadRequest)
+		return
+	}
+	if h.maxSize > 0 && length > h.maxSize {
+		w.WriteHeader(http.StatusRequestEntityTooLarge)
+		return
+	}
+	id, err := createUploadId()
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	u := upload{
+		Id:     id,
+		Name:   parseUploadMetadata(r.Header.Get(uploadHeaderMetadata))[uploadMetadataName],
+		Length: length,
+	}
+	if err := h.write(u); err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	w.Header().Set("Location", h.path+"/"+id)
+	w.WriteHeader(http.StatusCreated)
+}
+
+func (h *UploadHandler) status(w http.ResponseWriter, id string) {
+	u, offset, err := h.read(id)
+	if err != nil {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	w.Header().Set("Cache-Control", "no-store")
+	w.Header().Set(uploadHeaderLength, strconv.FormatInt(u.Length, 10))
+	w.Header().Set(uploadHeaderOffset, strconv.FormatInt(offset, 10))
+	w.WriteHeader(http.StatusOK)
+}
+
+func (h *UploadHandler) patch(w http.ResponseWriter, r *http.Request, id string) {
+	if r.Header.Get(contentType) != uploadContentType {
+		w.WriteHeader(http.StatusUnsupportedMediaType)
+		return
+	}
+	lock, _ := h.locks.LoadOrStore(id, new(sync.Mutex))
+	lock.(*sync.Mutex).Lock()
+	defer lock.(*sync.Mutex).Unlock()
+	u, offset, err := h.read(id)
+	if err != nil {
+		h.locks.Delete(id)
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	requestOffset, err := strconv.ParseInt(r.Header.Get(uploadHeaderOffset), 10, 64)
+	if err != nil || requestOffset != offset {
+		w.WriteHeader(http.StatusConflict)
+		return
+	}
+	f, err := os.OpenFile(h.dataPath(id), os.O_WRONLY|os.O_APPEND, 0o600)
+	if err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	written, err := io.Copy(f, io.LimitReader(r.Body, u.Length-offset))
+	closeErr := f.Close()
+	w.Header().Set(uploadHeaderOffset, strconv.FormatInt(offset+written, 10))
+	if err != nil || closeErr != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *UploadHandler) delete(w http.ResponseWriter, id string) {
+	if _, _, err := h.read(id); err != nil {
+		w.WriteHeader(http.StatusNotFound)
+		return
+	}
+	if err := h.Remove(id); err != nil {
+		w.WriteHeader(http.StatusInternalServerError)
+		return
+	}
+	w.WriteHeader(http.StatusNoContent)
+}
+
+func (h *UploadHandler) read(id string) (upload, int64, error) {
+	u := upload{}
+	info, err := os.ReadFile(h.infoPath(id))
+	if err != nil {
+		return u, 0, fmt.Errorf("error while reading upload %s: %w", id, err)
+	}
+	if err := json.Unmarshal(info, &u); err != nil {
+		return u, 0, fmt.Errorf("error while reading upload %s: %w", id, err)
+	}
+	stat, err := os.Stat(h.dataPath(id))
+	if err != nil {
+		return u, 0, fmt.Errorf("error while reading upload %s: %w", id, err)
+	}
+	return u, stat.Size(), nil
+}
+
+func (h *UploadHandler) write(u upload) error {
+	if err := os.MkdirAll(h.dir, 0o755); err != nil {
+		return err
+	}
+	info, err := json.Marshal(u)
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(h.dataPath(u.Id), nil, 0o600); err != nil {
+		return err
+	}
+	return os.WriteFile(h.infoPath(u.Id), info, 0o600)
+}
+
+func (h *UploadHandler) dataPath(id string) string {
+	return filepath.Join(h.dir, id)
+}
+
+func (h *UploadHandler) infoPath(id string) string {
+	return filepath.Join(h.dir, id+uploadInfoSuffix)
+}
+
+func createUploadId() (string, error) {
+	id := make([]byte, uploadIdLength)
+	if _, err := rand.Read(id); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(id), nil
+}
+
+func isUploadIdValid(id string) bool {
+	if len(id) != uploadIdLength*2 {
+		return false
+	}
+	_, err := hex.DecodeString(id)
+	return err == nil
+}
+
+func parseUploadMetadata(metadata string) map[string]string {
+	result := make(map[string]string)
+	for _, pair := range strings.Split(metadata, ",") {
+		key, value, _ := strings.Cut(strings.TrimSpace(pair), " ")
+		if len(key) == 0 {
+			continue
+		}
+		decoded, err := base64.StdEncoding.DecodeString(value)
+		if err != nil {
+			continue
+		}
+		result[key] = string(decoded)
+	}
+	return result
+}
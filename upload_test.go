@@ -0,0 +1,191 @@
+package form
+
+import (
+	"bytes"
+	"encoding/base64"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"os"
+	"strconv"
+	"testing"
+	"time"
+	
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUpload(t *testing.T) {
+	send := func(handler http.Handler, method, target string, body []byte, headers map[string]string) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(method, target, bytes.NewReader(body))
+		req.Header.Set(uploadHeaderResume, uploadVersion)
+		for key, value := range headers {
+			req.Header.Set(key, value)
+		}
+		res := httptest.NewRecorder()
+		handler.ServeHTTP(res, req)
+		return res
+	}
+	createUpload := func(handler *UploadHandler, data []byte) string {
+		res := send(
+			handler, http.MethodPost, "/uploads", nil, map[string]string{
+				uploadHeaderLength:   strconv.Itoa(len(data)),
+				uploadHeaderMetadata: "filename " + base64.StdEncoding.EncodeToString([]byte("../video.txt")),
+			},
+		)
+		assert.Equal(t, http.StatusCreated, res.Code)
+		return res.Header().Get("Location")
+	}
+	t.Run(
+		"resumable upload", func(t *testing.T) {
+			handler := NewUploadHandler(t.TempDir(), "/uploads/")
+			data := bytes.Repeat([]byte("test"), 1<<10)
+			location := createUpload(handler, data)
+			res := send(
+				handler, http.MethodPatch, location, data[:1000], map[string]string{
+					contentType:        uploadContentType,
+					uploadHeaderOffset: "0",
+				},
+			)
+			assert.Equal(t, http.StatusNoContent, res.Code)
+			assert.Equal(t, "1000", res.Header().Get(uploadHeaderOffset))
+			res = send(handler, http.MethodHead, location, nil, nil)
+			assert.Equal(t, http.StatusOK, res.Code)
+			assert.Equal(t, "1000", res.Header().Get(uploadHeaderOffset))
+			assert.Equal(t, strconv.Itoa(len(data)), res.Header().Get(uploadHeaderLength))
+			res = send(
+				handler, http.MethodPatch, location, data[1000:], map[string]string{
+					contentType:        uploadContentType,
+					uploadHeaderOffset: "500",
+				},
+			)
+			assert.Equal(t, http.StatusConflict, res.Code)
+			res = send(
+				handler, http.MethodPatch, location, data[1000:], map[string]string{
+					contentType:        uploadContentType,
+					uploadHeaderOffset: "1000",
+				},
+			)
+			assert.Equal(t, http.StatusNoContent, res.Code)
+			assert.Equal(t, strconv.Itoa(len(data)), res.Header().Get(uploadHeaderOffset))
+			id := location[len("/uploads/"):]
+			file, err := handler.Multipart(id)
+			assert.Nil(t, err)
+			assert.Equal(t, "video.txt", file.Name)
+			assert.Equal(t, "txt", file.Suffix)
+			assert.Equal(t, int64(len(data)), file.Size)
+			stored, err := os.ReadFile(file.Path)
+			assert.Nil(t, err)
+			assert.Equal(t, data, stored)
+		},
+	)
+	t.Run(
+		"protocol errors", func(t *testing.T) {
+			handler := NewUploadHandler(t.TempDir(), "/uploads", 10)
+			res := send(handler, http.MethodOptions, "/uploads", nil, nil)
+			assert.Equal(t, http.StatusNoContent, res.Code)
+			assert.Equal(t, "10", res.Header().Get(uploadHeaderMaxSize))
+			res = send(handler, http.MethodPost, "/uploads", nil, map[string]string{uploadHeaderLength: "11"})
+			assert.Equal(t, http.StatusRequestEntityTooLarge, res.Code)
+			res = send(handler, http.MethodPost, "/uploads", nil, map[string]string{uploadHeaderResume: "0.2.0"})
+			assert.Equal(t, http.StatusPreconditionFailed, res.Code)
+			res = send(handler, http.MethodHead, "/uploads/../../etc/passwd", nil, nil)
+			assert.Equal(t, http.StatusNotFound, res.Code)
+			location := createUpload(handler, []byte("test"))
+			_, err := handler.Multipart(location[len("/uploads/"):])
+			assert.NotNil(t, err)
+			res = send(handler, http.MethodDelete, location, nil, nil)
+			assert.Equal(t, http.StatusNoContent, res.Code)
+			res = send(handler, http.MethodHead, location, nil, nil)
+			assert.Equal(t, http.StatusNotFound, res.Code)
+		},
+	)
+	t.Run(
+		"build with upload id", func(t *testing.T) {
+			handler := NewUploadHandler(t.TempDir(), "/uploads")
+			data := []byte("test upload")
+			location := createUpload(handler, data)
+			send(
+				handler, http.MethodPatch, location, data, map[string]string{
+					contentType:        uploadContentType,
+					uploadHeaderOffset: "0",
+				},
+			)
+			req := testCreateValuesRequest(url.Values{"test": {location[len("/uploads/"):]}})
+			form, err := Build[testForm](
+				New(Add("test").With(File(), Validate.Required(), Validate.MaxSize(5)).Uploads(handler)).Request(req),
+			)
+			assert.Nil(t, err)
+			assert.Equal(t, "test", form.Test.Value.Key)
+			assert.Equal(t, int64(len(data)), form.Test.Value.Size)
+			assert.Equal(t, []string{defaultMultipartMessage}, form.Test.Messages)
+			req = testCreateValuesRequest(url.Values{"test": {"unknown"}})
+			form, err = Build[testForm](
+				New(Add("test").With(File(), Validate.Required()).Uploads(handler)).Request(req),
+			)
+			assert.Nil(t, err)
+			assert.Equal(t, []string{defaultRequiredMessage}, form.Test.Messages)
+		},
+	)
+	t.Run(
+		"stored upload is removed", func(t *testing.T) {
+			handler := NewUploadHandler(t.TempDir(), "/uploads")
+			data := []byte("test upload")
+			location := createUpload(handler, data)
+			send(
+				handler, http.MethodPatch, location, data, map[string]string{
+					contentType:        uploadContentType,
+					uploadHeaderOffset: "0",
+				},
+			)
+			id := location[len("/uploads/"):]
+			storage := NewMemoryStorage()
+			form, err := Build[testForm](
+				New(Add("test").With(File()).Uploads(handler).Storage(storage)).Request(
+					testCreateValuesRequest(url.Values{"test": {id}}),
+				),
+			)
+			assert.Nil(t, err)
+			assert.NotEqual(t, "", form.Test.Value.StorageKey)
+			assert.Equal(t, "", form.Test.Value.Path)
+			_, err = os.Stat(handler.dataPath(id))
+			assert.True(t, os.IsNotExist(err))
+			_, err = os.Stat(handler.infoPath(id))
+			assert.True(t, os.IsNotExist(err))
+		},
+	)
+	t.Run(
+		"cleanup", func(t *testing.T) {
+			handler := NewUploadHandler(t.TempDir(), "/uploads")
+			location := createUpload(handler, []byte("test"))
+			id := location[len("/uploads/"):]
+			send(
+				handler, http.MethodPatch, location, []byte("te"), map[string]string{
+					contentType:        uploadContentType,
+					uploadHeaderOffset: "0",
+				},
+			)
+			_, ok := handler.locks.Load(id)
+			assert.True(t, ok)
+			assert.Nil(t, handler.Cleanup(time.Hour))
+			_, _, err := handler.read(id)
+			assert.Nil(t, err)
+			old := time.Now().Add(-2 * time.Hour)
+			assert.Nil(t, os.Chtimes(handler.infoPath(id), old, old))
+			assert.Nil(t, os.Chtimes(handler.dataPath(id), old, old))
+			assert.Nil(t, handler.Cleanup(time.Hour))
+			_, _, err = handler.read(id)
+			assert.NotNil(t, err)
+			_, ok = handler.locks.Load(id)
+			assert.False(t, ok)
+			res := send(
+				handler, http.MethodPatch, location, []byte("st"), map[string]string{
+					contentType:        uploadContentType,
+					uploadHeaderOffset: "2",
+				},
+			)
+			assert.Equal(t, http.StatusNotFound, res.Code)
+			_, ok = handler.locks.Load(id)
+			assert.False(t, ok)
+		},
+	)
+}
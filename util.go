@@ -4,6 +4,7 @@ import (
 	"net/http"
 	"strconv"
 	"strings"
+	"time"
 )
 
 func isRequestForm(req *http.Request) bool {
@@ -45,9 +46,36 @@ func convertToFloat(v string) float64 {
 }
 
 func getFileSuffixFromName(filename string) string {
-	parts := strings.Split(filename, ".")
-	if len(parts) < 2 {
+	index := strings.LastIndex(filename, ".")
+	if index < 1 || index == len(filename)-1 {
 		return ""
 	}
-	return parts[1]
+	return strings.ToLower(filename[index+1:])
+}
+
+func isValuePresent(dataType string, values []string) bool {
+	if len(values) == 0 {
+		return false
+	}
+	for _, v := range values {
+		if len(v) == 0 {
+			return false
+		}
+		if dataType == fieldDataTypeTime {
+			if _, err := time.Parse(fieldTimeFormat, v); err != nil {
+				return false
+			}
+		}
+	}
+	return true
+}
+
+func isFileValuePresent(value any) bool {
+	switch v := value.(type) {
+	case Multipart:
+		return !v.isEmpty()
+	case []Multipart:
+		return len(v) > 0
+	}
+	return false
 }
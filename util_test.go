@@ -56,6 +56,10 @@ func TestUtil(t *testing.T) {
 			assert.Equal(t, "png", getFileSuffixFromName("image.png"))
 			assert.Equal(t, "pdf", getFileSuffixFromName("abc.pdf"))
 			assert.Equal(t, "txt", getFileSuffixFromName("test.txt"))
+			assert.Equal(t, "gz", getFileSuffixFromName("archive.tar.gz"))
+			assert.Equal(t, "jpg", getFileSuffixFromName("my.photo.JPG"))
+			assert.Equal(t, "", getFileSuffixFromName(".gitignore"))
+			assert.Equal(t, "", getFileSuffixFromName("README"))
 		},
 	)
 }
@@ -1,6 +1,7 @@
 package form
 
 import (
+	"fmt"
 	"net/http"
 	"regexp"
 )
@@ -13,6 +14,7 @@ type validator struct {
 	validatorType int
 	value         any
 	pattern       string
+	regexp        *regexp.Regexp
 }
 
 const (
@@ -25,9 +27,33 @@ const (
 	validatorTypeMax
 	validatorTypeEmail
 	validatorTypeCustom
+	validatorTypeMaxSize
+	validatorTypeMinSize
+	validatorTypeMimeTypes
+	validatorTypeExtensions
+	validatorTypeImage
+	validatorTypePositive
+	validatorTypeDecimals
+	validatorTypeCurrencies
+	validatorTypeUrl
+	validatorTypeUuid
+	validatorTypePhone
+	validatorTypeIban
+	validatorTypeCzechIco
+	validatorTypeCzechDic
+	validatorTypeCreditCard
+	validatorTypeHexColor
+	validatorTypePostalCode
+	validatorTypeMinLength
+	validatorTypeMaxLength
+)
+
+var (
+	emailRegexp = compilePattern(validatorEmail)
 )
 
 func CreateValidator[T any](pattern string) func(value ...T) Validator {
+	compiled := compilePattern(pattern)
 	return func(value ...T) Validator {
 		v := *new(T)
 		if len(value) > 0 {
@@ -36,6 +62,7 @@ func CreateValidator[T any](pattern string) func(value ...T) Validator {
 		return validator{
 			validatorType: validatorTypeCustom,
 			pattern:       pattern,
+			regexp:        compiled,
 			value:         v,
 		}
 	}
@@ -53,28 +80,85 @@ func (v Validators) Email() Validator {
 	return validator{
 		validatorType: validatorTypeEmail,
 		pattern:       validatorEmail,
+		regexp:        emailRegexp,
 	}
 }
 
-func (v Validators) Min(value int) Validator {
+func (v Validators) Min(value any) Validator {
 	return validator{
 		validatorType: validatorTypeMin,
-		value:         value,
+		value:         checkNumberBound(value),
 	}
 }
 
-func (v Validators) Max(value int) Validator {
+func (v Validators) Max(value any) Validator {
 	return validator{
 		validatorType: validatorTypeMax,
-		value:         value,
+		value:         checkNumberBound(value),
+	}
+}
+
+func validateFields(fields []*FieldBuilder, messages Messages, req *http.Request) {
+	validateFieldsErrors(fields, messages, req)
+	focusFields(fields, findInvalidField(fields))
+}
+
+func validateFieldsErrors(fields []*FieldBuilder, messages Messages, req *http.Request) {
+	for _, fb := range fields {
+		fb.messages = messages
+		fb.errors = validateField(fb, req)
+		if fb.group == nil {
+			continue
+		}
+		if !fb.multiple {
+			fb.group.item(0)
+		}
+		for _, item := range fb.group.items {
+			validateFieldsErrors(item.fields, messages, req)
+		}
+	}
+}
+
+func findInvalidField(fields []*FieldBuilder) *FieldBuilder {
+	for _, fb := range fields {
+		if len(fb.errors) > 0 {
+			return fb
+		}
+		if fb.group == nil {
+			continue
+		}
+		for _, item := range fb.group.items {
+			if invalid := findInvalidField(item.fields); invalid != nil {
+				return invalid
+			}
+		}
+	}
+	return nil
+}
+
+func focusFields(fields []*FieldBuilder, invalid *FieldBuilder) {
+	for _, fb := range fields {
+		fb.focus = invalid == nil && fb.autofocus || fb == invalid
+		if fb.group == nil {
+			continue
+		}
+		for _, item := range fb.group.items {
+			focusFields(item.fields, invalid)
+		}
 	}
 }
 
 func validateField(fb *FieldBuilder, req *http.Request) []string {
 	errors := make([]string, 0)
-	if req != nil && req.Method == http.MethodGet {
+	if req != nil && (req.Method == http.MethodGet || isGroupAction(req)) {
 		return errors
 	}
+	if fb.dataType == fieldDataTypeGroup {
+		return validateGroup(fb)
+	}
+	if fb.invalid {
+		return append(errors, fb.messages.Invalid)
+	}
 	for _, v := range fb.validators {
 		switch v.validatorType {
 		case validatorTypeRequired:
@@ -87,57 +171,132 @@ func validateField(fb *FieldBuilder, req *http.Request) []string {
 			errors = append(errors, validateEmail(fb, v)...)
 		case validatorTypeCustom:
 			errors = append(errors, validateCustom(fb, v)...)
+		case validatorTypeMaxSize:
+			errors = append(errors, validateMaxSize(fb, v)...)
+		case validatorTypeMinSize:
+			errors = append(errors, validateMinSize(fb, v)...)
+		case validatorTypeMimeTypes:
+			errors = append(errors, validateMimeTypes(fb, v)...)
+		case validatorTypeExtensions:
+			errors = append(errors, validateExtensions(fb, v)...)
+		case validatorTypeImage:
+			errors = append(errors, validateImage(fb, v)...)
+		case validatorTypePositive:
+			errors = append(errors, validatePositive(fb)...)
+		case validatorTypeDecimals:
+			errors = append(errors, validateDecimals(fb, v)...)
+		case validatorTypeCurrencies:
+			errors = append(errors, validateCurrencies(fb, v)...)
+		case validatorTypeUrl:
+			errors = append(
+				errors, validateFormat(
+					fb, fb.messages.Url, func(value string) bool {
+						return isUrlValid(value, v.value.([]string))
+					},
+				)...,
+			)
+		case validatorTypeUuid:
+			errors = append(errors, validateFormat(fb, fb.messages.Uuid, uuidRegexp.MatchString)...)
+		case validatorTypePhone:
+			errors = append(errors, validateFormat(fb, fb.messages.Phone, e164PhoneRegexp.MatchString)...)
+		case validatorTypeIban:
+			errors = append(errors, validateFormat(fb, fb.messages.Iban, isIbanValid)...)
+		case validatorTypeCzechIco:
+			errors = append(errors, validateFormat(fb, fb.messages.Ico, isCzechIcoValid)...)
+		case validatorTypeCzechDic:
+			errors = append(errors, validateFormat(fb, fb.messages.Dic, isCzechDicValid)...)
+		case validatorTypeCreditCard:
+			errors = append(errors, validateFormat(fb, fb.messages.CreditCard, isCreditCardValid)...)
+		case validatorTypeHexColor:
+			errors = append(errors, validateFormat(fb, fb.messages.HexColor, hexColorRegexp.MatchString)...)
+		case validatorTypePostalCode:
+			errors = append(
+				errors, validateFormat(
+					fb, fb.messages.PostalCode, func(value string) bool {
+						return isPostalCodeValid(value, v.value.(string))
+					},
+				)...,
+			)
+		case validatorTypeMinLength:
+			errors = append(
+				errors, validateLength(
+					fb, fb.messages.MinText, func(value string) bool {
+						l := v.value.(length)
+						return getTextLength(value, l.unit) >= l.value
+					},
+				)...,
+			)
+		case validatorTypeMaxLength:
+			errors = append(
+				errors, validateLength(
+					fb, fb.messages.MaxText, func(value string) bool {
+						l := v.value.(length)
+						return getTextLength(value, l.unit) <= l.value
+					},
+				)...,
+			)
 		}
 	}
 	return errors
 }
 
-func validateRequired(fb *FieldBuilder) []string {
+func validateGroup(fb *FieldBuilder) []string {
 	errors := make([]string, 0)
-	switch fv := fb.value.(type) {
-	case []string:
-		if len(fv) == 0 {
-			errors = append(errors, fb.messages.Required)
-		}
-		if len(fv) > 0 {
-			for _, item := range fv {
-				if len(item) == 0 {
-					errors = append(errors, fb.messages.Required)
-					break
-				}
+	if !fb.multiple {
+		for _, v := range fb.validators {
+			if v.validatorType == validatorTypeRequired && !isGroupPresent(fb) {
+				errors = append(errors, fb.messages.Required)
 			}
 		}
-	case []int:
-		if len(fv) == 0 {
-			errors = append(errors, fb.messages.Required)
+		return errors
+	}
+	count := len(fb.group.items)
+	for _, v := range fb.validators {
+		switch v.validatorType {
+		case validatorTypeRequired:
+			if count == 0 {
+				errors = append(errors, fb.messages.Required)
+			}
+		case validatorTypeMin:
+			if count < convertBoundToInt(v.value) {
+				errors = append(errors, fb.messages.MinItems)
+			}
+		case validatorTypeMax:
+			if count > convertBoundToInt(v.value) {
+				errors = append(errors, fb.messages.MaxItems)
+			}
 		}
-		if len(fv) > 0 {
-			for _, item := range fv {
-				if item < 1 {
-					errors = append(errors, fb.messages.Required)
-					break
-				}
+	}
+	return errors
+}
+
+func isGroupPresent(fb *FieldBuilder) bool {
+	for _, item := range fb.group.items {
+		for _, field := range item.fields {
+			if field.present || isFileValuePresent(field.value) || field.group != nil && isGroupPresent(field) {
+				return true
 			}
 		}
-	case []float64:
-		if len(fv) == 0 {
+	}
+	return false
+}
+
+func validateRequired(fb *FieldBuilder) []string {
+	errors := make([]string, 0)
+	if fb.isNumber() || fb.dataType == fieldDataTypeCustom || fb.dataType == fieldDataTypeEnum {
+		if !fb.present || len(getValueItems(fb.value)) == 0 {
 			errors = append(errors, fb.messages.Required)
 		}
-		if len(fv) > 0 {
-			for _, item := range fv {
-				if item < 0.01 {
-					errors = append(errors, fb.messages.Required)
-					break
-				}
-			}
-		}
-	case []float32:
+		return errors
+	}
+	switch fv := fb.value.(type) {
+	case []string:
 		if len(fv) == 0 {
 			errors = append(errors, fb.messages.Required)
 		}
 		if len(fv) > 0 {
 			for _, item := range fv {
-				if item < 0.01 {
+				if len(item) == 0 {
 					errors = append(errors, fb.messages.Required)
 					break
 				}
@@ -161,7 +320,7 @@ func validateRequired(fb *FieldBuilder) []string {
 		}
 		if len(fv) > 0 {
 			for _, item := range fv {
-				if len(item.Data) == 0 {
+				if item.isEmpty() {
 					errors = append(errors, fb.messages.Required)
 					break
 				}
@@ -172,25 +331,13 @@ func validateRequired(fb *FieldBuilder) []string {
 		if len(fv) == 0 {
 			errors = append(errors, fb.messages.Required)
 		}
-	case int:
-		if fv < 1 {
-			errors = append(errors, fb.messages.Required)
-		}
-	case float64:
-		if fv < 0.01 {
-			errors = append(errors, fb.messages.Required)
-		}
-	case float32:
-		if fv < 0.01 {
-			errors = append(errors, fb.messages.Required)
-		}
 	case bool:
 		if !fv {
 			errors = append(errors, fb.messages.Required)
 		}
 	
 	case Multipart:
-		if len(fv.Data) == 0 {
+		if fv.isEmpty() {
 			errors = append(errors, fb.messages.Required)
 		}
 	}
@@ -199,106 +346,84 @@ func validateRequired(fb *FieldBuilder) []string {
 
 func validateMin(fb *FieldBuilder, v validator) []string {
 	errors := make([]string, 0)
-	vv := v.value.(int)
-	switch fv := fb.value.(type) {
-	case []string:
-		for _, item := range fv {
-			if len(item) < vv {
-				errors = append(errors, fb.messages.MinText)
-				break
-			}
+	if fb.isNumber() {
+		if !fb.present {
+			return errors
 		}
-	case []int:
-		for _, item := range fv {
-			if item < vv {
+		for _, item := range getValueItems(fb.value) {
+			if result, ok := compareNumber(item, v.value); ok && result < 0 {
 				errors = append(errors, fb.messages.MinNumber)
 				break
 			}
 		}
-	case []float32:
-		for _, item := range fv {
-			if item < float32(vv) {
-				errors = append(errors, fb.messages.MinNumber)
-				break
-			}
+		return errors
+	}
+	vv := convertBoundToInt(v.value)
+	if len(fb.options) > 0 {
+		if len(getValueItems(fb.value)) < vv {
+			errors = append(errors, fb.messages.MinItems)
 		}
-	case []float64:
+		return errors
+	}
+	switch fv := fb.value.(type) {
+	case []string:
 		for _, item := range fv {
-			if item < float64(vv) {
-				errors = append(errors, fb.messages.MinNumber)
+			if getTextLength(item, LengthUtf16) < vv {
+				errors = append(errors, fb.messages.MinText)
 				break
 			}
 		}
+	case []Multipart:
+		if len(fv) < vv {
+			errors = append(errors, fb.messages.Multipart)
+		}
 	
 	case string:
-		if len(fv) < vv {
+		if getTextLength(fv, LengthUtf16) < vv {
 			errors = append(errors, fb.messages.MinText)
 		}
-	case int:
-		if fv < vv {
-			errors = append(errors, fb.messages.MinNumber)
-		}
-	case float32:
-		if fv < float32(vv) {
-			errors = append(errors, fb.messages.MinNumber)
-		}
-	case float64:
-		if fv < float64(vv) {
-			errors = append(errors, fb.messages.MinNumber)
-		}
 	}
 	return errors
 }
 
 func validateMax(fb *FieldBuilder, v validator) []string {
 	errors := make([]string, 0)
-	vv := v.value.(int)
-	switch fv := fb.value.(type) {
-	case []string:
-		for _, item := range fv {
-			if len(item) > vv {
-				errors = append(errors, fb.messages.MaxText)
-				break
-			}
+	if fb.isNumber() {
+		if !fb.present {
+			return errors
 		}
-	case []int:
-		for _, item := range fv {
-			if item > vv {
+		for _, item := range getValueItems(fb.value) {
+			if result, ok := compareNumber(item, v.value); ok && result > 0 {
 				errors = append(errors, fb.messages.MaxNumber)
 				break
 			}
 		}
-	case []float32:
-		for _, item := range fv {
-			if item > float32(vv) {
-				errors = append(errors, fb.messages.MaxNumber)
-				break
-			}
+		return errors
+	}
+	vv := convertBoundToInt(v.value)
+	if len(fb.options) > 0 {
+		if len(getValueItems(fb.value)) > vv {
+			errors = append(errors, fb.messages.MaxItems)
 		}
-	case []float64:
+		return errors
+	}
+	switch fv := fb.value.(type) {
+	case []string:
 		for _, item := range fv {
-			if item > float64(vv) {
-				errors = append(errors, fb.messages.MaxNumber)
+			if getTextLength(item, LengthUtf16) > vv {
+				errors = append(errors, fb.messages.MaxText)
 				break
 			}
 		}
+	case []Multipart:
+		if len(fv) > vv {
+			errors = append(errors, fb.messages.Multipart)
+		}
 	
 	case string:
-		if len(fv) > vv {
+		if getTextLength(fv, LengthUtf16) > vv {
 			errors = append(errors, fb.messages.MaxText)
 		}
-	case int:
-		if fv > vv {
-			errors = append(errors, fb.messages.MaxNumber)
-		}
-	case float32:
-		if fv > float32(vv) {
-			errors = append(errors, fb.messages.MaxNumber)
-		}
-	case float64:
-		if fv > float64(vv) {
-			errors = append(errors, fb.messages.MaxNumber)
-		}
 	}
 	return errors
 }
@@ -308,23 +433,13 @@ func validateEmail(fb *FieldBuilder, v validator) []string {
 	switch fv := fb.value.(type) {
 	case []string:
 		for _, item := range fv {
-			ok, err := regexp.MatchString(v.pattern, item)
-			if err != nil {
-				errors = append(errors, err.Error())
-			}
-			if !ok {
+			if !v.regexp.MatchString(item) {
 				errors = append(errors, fb.messages.Email)
 			}
 		}
 	case string:
-		if len(fv) > 0 {
-			ok, err := regexp.MatchString(v.pattern, fv)
-			if err != nil {
-				errors = append(errors, err.Error())
-			}
-			if !ok {
-				errors = append(errors, fb.messages.Email)
-			}
+		if len(fv) > 0 && !v.regexp.MatchString(fv) {
+			errors = append(errors, fb.messages.Email)
 		}
 	}
 	return errors
@@ -335,24 +450,29 @@ func validateCustom(fb *FieldBuilder, v validator) []string {
 	switch fv := fb.value.(type) {
 	case []string:
 		for _, item := range fv {
-			ok, err := regexp.MatchString(v.pattern, item)
-			if err != nil {
-				errors = append(errors, err.Error())
-			}
-			if !ok {
+			if !v.regexp.MatchString(item) {
 				errors = append(errors, fb.messages.Invalid)
 			}
 		}
 	case string:
-		if len(fv) > 0 {
-			ok, err := regexp.MatchString(v.pattern, fv)
-			if err != nil {
-				errors = append(errors, err.Error())
-			}
-			if !ok {
-				errors = append(errors, fb.messages.Invalid)
-			}
+		if len(fv) > 0 && !v.regexp.MatchString(fv) {
+			errors = append(errors, fb.messages.Invalid)
 		}
 	}
 	return errors
 }
+
+func checkNumberBound(bound any) any {
+	if _, ok := convertToRat(bound); !ok {
+		panic(fmt.Errorf("error while creating validator bound %v: bound must be finite integer, float, Decimal or Amount", bound))
+	}
+	return bound
+}
+
+func compilePattern(pattern string) *regexp.Regexp {
+	compiled, err := regexp.Compile("^(?:" + pattern + ")$")
+	if err != nil {
+		panic(fmt.Errorf("error while compiling validator pattern %s: %w", pattern, err))
+	}
+	return compiled
+}
@@ -1,6 +1,7 @@
 package form
 
 import (
+	"net/url"
 	"testing"
 	
 	"github.com/stretchr/testify/assert"
@@ -22,6 +23,74 @@ func TestValidator(t *testing.T) {
 			assert.Equal(t, defaultRequiredMessage, form.Amount.Messages[0])
 		},
 	)
+	t.Run(
+		"required zero", func(t *testing.T) {
+			req := testCreateValuesRequest(url.Values{"quantity": {"0"}, "amount": {"-1.5"}})
+			form, err := Build[testForm](
+				New(
+					Add("quantity").With(Number[int](), Validate.Required()),
+					Add("amount").With(Number[float64](), Validate.Required(), Validate.Min(-5)),
+				).Request(req),
+			)
+			assert.Nil(t, err)
+			assert.True(t, form.Valid)
+			assert.True(t, form.Quantity.Present)
+			assert.Equal(t, 0, form.Quantity.Value)
+			assert.Equal(t, -1.5, form.Amount.Value)
+		},
+	)
+	t.Run(
+		"required empty", func(t *testing.T) {
+			req := testCreateValuesRequest(url.Values{"quantity": {""}, "amount": {"abc"}})
+			form, err := Build[testForm](
+				New(
+					Add("quantity").With(Number[int](), Validate.Required()),
+					Add("amount").With(Number[float64](), Validate.Required()),
+				).Request(req),
+			)
+			assert.Nil(t, err)
+			assert.False(t, form.Valid)
+			assert.False(t, form.Quantity.Present)
+			assert.Equal(t, []string{defaultRequiredMessage}, form.Quantity.Messages)
+			assert.Equal(t, []string{defaultInvalidMessage}, form.Amount.Messages)
+		},
+	)
+	t.Run(
+		"required default not submitted", func(t *testing.T) {
+			b := New(
+				Add("name").With(Text()),
+				Add("quantity").With(Number[int](5), Validate.Required()),
+			)
+			form, err := Build[testForm](b.Request(testCreateValuesRequest(url.Values{"quantity": {"3"}})))
+			assert.Nil(t, err)
+			assert.True(t, form.Valid)
+			assert.True(t, form.Quantity.Present)
+			form, err = Build[testForm](b.Request(testCreateValuesRequest(url.Values{"name": {"Test"}})))
+			assert.Nil(t, err)
+			assert.False(t, form.Valid)
+			assert.False(t, form.Quantity.Present)
+			assert.Equal(t, []string{defaultRequiredMessage}, form.Quantity.Messages)
+			money, err := Build[testMoneyForm](
+				New(
+					Add("price").With(Money(NewAmount(MustParseDecimal("10"), "CZK")), Validate.Required()),
+					Add("discount").With(Number[Decimal]()),
+				).Request(testCreateValuesRequest(url.Values{"discount": {"1"}})),
+			)
+			assert.Nil(t, err)
+			assert.False(t, money.Valid)
+			assert.False(t, money.Price.Present)
+			assert.Equal(t, []string{defaultRequiredMessage}, money.Price.Messages)
+		},
+	)
+	t.Run(
+		"optional min", func(t *testing.T) {
+			req := testCreateValuesRequest(url.Values{"quantity": {""}})
+			form, err := Build[testForm](New(Add("quantity").With(Number[int](), Validate.Min(1))).Request(req))
+			assert.Nil(t, err)
+			assert.True(t, form.Valid)
+			assert.Equal(t, 0, len(form.Quantity.Messages))
+		},
+	)
 	t.Run(
 		"email valid", func(t *testing.T) {
 			form, err := Build[testForm](
@@ -78,4 +147,30 @@ func TestValidator(t *testing.T) {
 			assert.Equal(t, defaultRequiredMessage, form.Roles.Messages[0])
 		},
 	)
+	t.Run(
+		"custom anchored", func(t *testing.T) {
+			digits := CreateValidator[string]("[0-9]+")
+			for value, valid := range map[string]bool{"123": true, "abc1": false, "1abc": false, "": true} {
+				form, err := Build[testForm](New(Add("name").With(Text(value), digits())))
+				assert.Nil(t, err)
+				assert.Equal(t, valid, len(form.Name.Messages) == 0, value)
+			}
+		},
+	)
+	t.Run(
+		"custom invalid pattern", func(t *testing.T) {
+			assert.Panics(
+				t, func() {
+					CreateValidator[string]("[0-9")
+				},
+			)
+		},
+	)
+	t.Run(
+		"email anchored", func(t *testing.T) {
+			form, err := Build[testForm](New(Add("email").With(Email("x test@test.cz"), Validate.Email())))
+			assert.Nil(t, err)
+			assert.Equal(t, []string{defaultEmailMessage}, form.Email.Messages)
+		},
+	)
 }